@@ -0,0 +1,74 @@
+package app
+
+import (
+	cheqdante "github.com/cheqd/cheqd-node/x/cheqd/ante"
+	cheqdkeeper "github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// HandlerOptions extends the SDK's AnteHandler options by requiring the keepers used by cheqd decorators.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// CheqdBankKeeper is the bank keeper with permissions to burn identity fees
+	CheqdBankKeeper    cheqdtypes.BankKeeper
+	DistributionKeeper cheqdtypes.DistributionKeeper
+	CheqdKeeper        *cheqdkeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK's default AnteHandler extended with the decorators
+// charging identity fees and verifying identity signatures during CheckTx.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.CheqdBankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cheqd bank keeper is required for ante builder")
+	}
+
+	if options.DistributionKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "distribution keeper is required for ante builder")
+	}
+
+	if options.CheqdKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cheqd keeper is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		cheqdante.NewPaymentMemoDecorator(), // makes the payment request id of the memo available to bank messages
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		cheqdante.NewDeductIdentityFeeDecorator(options.AccountKeeper, options.CheqdBankKeeper, options.DistributionKeeper, *options.CheqdKeeper),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		cheqdante.NewIdentitySigVerificationDecorator(*options.CheqdKeeper),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
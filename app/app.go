@@ -36,6 +36,7 @@ import (
 
 	appparams "github.com/cheqd/cheqd-node/app/params"
 	"github.com/cheqd/cheqd-node/x/cheqd"
+	cheqdclient "github.com/cheqd/cheqd-node/x/cheqd/client"
 	cheqdkeeper "github.com/cheqd/cheqd-node/x/cheqd/keeper"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
@@ -115,6 +116,12 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		upgradeclient.CancelProposalHandler,
 		ibcclientclient.UpdateClientProposalHandler,
 		ibcclientclient.UpgradeProposalHandler,
+		cheqdclient.SetNamespacesProposalHandler,
+		cheqdclient.DeactivateDidProposalHandler,
+		cheqdclient.AddTrustedIssuersProposalHandler,
+		cheqdclient.RemoveTrustedIssuersProposalHandler,
+		cheqdclient.SetReservedIdsProposalHandler,
+		cheqdclient.ReleaseReservedIdsProposalHandler,
 	)
 
 	return govProposalHandlers
@@ -158,6 +165,8 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:            {authtypes.Burner},
+		cheqdtypes.ModuleName:          {authtypes.Burner},
+		cheqdtypes.EscrowAccountName:   nil,
 	}
 )
 
@@ -375,8 +384,9 @@ func New(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	app.cheqdKeeper = *cheqdkeeper.NewKeeper(
-		appCodec, keys[cheqdtypes.StoreKey],
+		appCodec, keys[cheqdtypes.StoreKey], app.GetSubspace(cheqdtypes.ModuleName), app.BankKeeper,
 	)
+	govRouter.AddRoute(cheqdtypes.RouterKey, cheqd.NewProposalHandler(app.cheqdKeeper, &app.GovKeeper))
 
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -404,7 +414,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		NewBankAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.cheqdKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
@@ -533,15 +543,20 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
-	handlerOptions := ante.HandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		FeegrantKeeper:  app.FeegrantKeeper,
-		SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
-		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	handlerOptions := HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeegrantKeeper,
+			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		CheqdBankKeeper:    app.BankKeeper,
+		DistributionKeeper: app.DistrKeeper,
+		CheqdKeeper:        &app.cheqdKeeper,
 	}
 
-	anteHandler, err := ante.NewAnteHandler(handlerOptions)
+	anteHandler, err := NewAnteHandler(handlerOptions)
 	if err != nil {
 		tmos.Exit(err.Error())
 	}
@@ -573,7 +588,7 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 
 func (app *App) TestNetMigration(ctx sdk.Context) {
 	if ctx.ChainID() == "cheqd-testnet-2" {
-		app.cheqdKeeper.SetDidNamespace(ctx, "testnet")
+		app.cheqdKeeper.SetNamespace(ctx, cheqdtypes.NewNamespace("testnet", cheqdtypes.NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
 	}
 }
 
@@ -722,6 +737,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(cheqdtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -0,0 +1,36 @@
+package app
+
+import (
+	cheqdkeeper "github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankAppModule is the bank module with the Msg server that records receipts of ADR-001 payments
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper      bankkeeper.Keeper
+	cheqdKeeper cheqdkeeper.Keeper
+}
+
+func NewBankAppModule(cdc codec.Codec, keeper bankkeeper.Keeper, accountKeeper banktypes.AccountKeeper, cheqdKeeper cheqdkeeper.Keeper) BankAppModule {
+	return BankAppModule{
+		AppModule:   bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:      keeper,
+		cheqdKeeper: cheqdKeeper,
+	}
+}
+
+// RegisterServices registers the same services as the bank module, wrapping its Msg server
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	msgServer := cheqdkeeper.NewPaymentMsgServer(bankkeeper.NewMsgServerImpl(am.keeper), am.cheqdKeeper)
+	banktypes.RegisterMsgServer(cfg.MsgServer(), msgServer)
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.(bankkeeper.BaseKeeper))
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	cheqdtypes "github.com/cheqd/cheqd-node/x/cheqd/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,5 +9,5 @@ func (app *App) Migration05(ctx sdk.Context) {
 	oldKey := "testnettestnet"
 	namespase := app.cheqdKeeper.GetFromState(ctx, oldKey)
 	app.cheqdKeeper.DeteteFromState(ctx, oldKey)
-	app.cheqdKeeper.SetDidNamespace(ctx, namespase)
+	app.cheqdKeeper.SetNamespace(ctx, cheqdtypes.NewNamespace(namespase, cheqdtypes.NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
 }
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/multiformats/go-multibase"
 	"github.com/spf13/cobra"
@@ -26,7 +27,7 @@ func ed25519Cmd() *cobra.Command {
 		Short: "ed25519 tools",
 	}
 
-	cmd.AddCommand(ed25519RandomCmd(), ed25519PubKeyBase64ToJwkCmd())
+	cmd.AddCommand(ed25519RandomCmd(), ed25519PubKeyBase64ToJwkCmd(), ed25519SelfCertifyingDidCmd())
 
 	return cmd
 }
@@ -96,6 +97,34 @@ func ed25519PubKeyBase64ToJwkCmd() *cobra.Command {
 	return cmd
 }
 
+// ed25519SelfCertifyingDidCmd returns cobra Command.
+func ed25519SelfCertifyingDidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "self-certifying-did [namespace] [pub-key-base64]",
+		Short: "Derive self-certifying DID from ed25519 pubkey base64",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace := args[0]
+			pubKeyBase64 := args[1]
+
+			pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyBase64)
+			if err != nil {
+				return err
+			}
+
+			uniqueId, err := utils.DeriveSelfCertifyingId(pubKeyBytes)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), utils.JoinDID("cheqd", namespace, uniqueId))
+			return err
+		},
+	}
+
+	return cmd
+}
+
 // encoding returns cobra Command.
 func encodingCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,126 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// DeductIdentityFeeDecorator charges fixed identity fees for DID transactions on top of gas.
+// DIDs are deactivated by governance, so the deactivation fee is charged for submitting the proposal.
+// Fees are paid by the fee payer of the transaction and are split between burning,
+// the community pool and the fee collector according to the module params.
+// It must be placed after ante.DeductFeeDecorator.
+type DeductIdentityFeeDecorator struct {
+	ak          types.AccountKeeper
+	bk          types.BankKeeper
+	dk          types.DistributionKeeper
+	cheqdKeeper keeper.Keeper
+}
+
+func NewDeductIdentityFeeDecorator(ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper, cheqdKeeper keeper.Keeper) DeductIdentityFeeDecorator {
+	return DeductIdentityFeeDecorator{
+		ak:          ak,
+		bk:          bk,
+		dk:          dk,
+		cheqdKeeper: cheqdKeeper,
+	}
+}
+
+func (dfd DeductIdentityFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeParams := dfd.cheqdKeeper.GetParams(ctx).FeeParams
+
+	fee := GetIdentityFee(tx.GetMsgs(), *feeParams)
+	if fee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	err = DeductIdentityFee(ctx, dfd.ak, dfd.bk, dfd.dk, *feeParams, feeTx.FeePayer(), fee)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GetIdentityFee returns the sum of identity fees for the messages, including messages wrapped into authz MsgExec
+func GetIdentityFee(msgs []sdk.Msg, feeParams types.FeeParams) sdk.Coins {
+	fee := sdk.NewCoins()
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgCreateDid:
+			fee = fee.Add(*feeParams.CreateDid)
+
+		case *types.MsgCreateDidBatch:
+			for range msg.Payload.Dids {
+				fee = fee.Add(*feeParams.CreateDid)
+			}
+
+		case *types.MsgUpdateDid, *types.MsgPatchDid:
+			fee = fee.Add(*feeParams.UpdateDid)
+
+		case *govtypes.MsgSubmitProposal:
+			// Paid by the proposer whether the proposal passes or not, like a deposit that is never refunded
+			if _, ok := msg.GetContent().(*types.DeactivateDidProposal); ok {
+				fee = fee.Add(*feeParams.DeactivateDid)
+			}
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				// Will be rejected by the authz message handler
+				continue
+			}
+
+			fee = fee.Add(GetIdentityFee(innerMsgs, feeParams)...)
+		}
+	}
+
+	return fee
+}
+
+// DeductIdentityFee takes the fee from the payer and distributes it according to the fee params
+func DeductIdentityFee(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, dk types.DistributionKeeper, feeParams types.FeeParams, payer sdk.AccAddress, fee sdk.Coins) error {
+	burn, communityPool, feeCollector, err := feeParams.SplitFee(fee)
+	if err != nil {
+		return types.ErrInternal.Wrap(err.Error())
+	}
+
+	err = bk.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "identity fee %s: %s", fee, err.Error())
+	}
+
+	if !burn.IsZero() {
+		err = bk.BurnCoins(ctx, types.ModuleName, burn)
+		if err != nil {
+			return types.ErrInternal.Wrap(err.Error())
+		}
+	}
+
+	if !communityPool.IsZero() {
+		err = dk.FundCommunityPool(ctx, communityPool, ak.GetModuleAddress(types.ModuleName))
+		if err != nil {
+			return types.ErrInternal.Wrap(err.Error())
+		}
+	}
+
+	if !feeCollector.IsZero() {
+		err = bk.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, feeCollector)
+		if err != nil {
+			return types.ErrInternal.Wrap(err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PaymentMemoDecorator reads the ADR-001 payment request id from the transaction memo once,
+// so bank messages of the transaction can record payment receipts without decoding the transaction again.
+type PaymentMemoDecorator struct{}
+
+func NewPaymentMemoDecorator() PaymentMemoDecorator {
+	return PaymentMemoDecorator{}
+}
+
+func (pmd PaymentMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	txWithMemo, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	requestId, found := types.GetPaymentRequestId(txWithMemo.GetMemo())
+	if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	return next(types.WithPaymentRequestId(ctx, requestId), tx, simulate)
+}
@@ -0,0 +1,66 @@
+package ante
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// IdentitySigVerificationDecorator verifies identity signatures during CheckTx,
+// so that transactions with bad DID signatures don't get into the mempool.
+// Messages are not executed, state dependent checks are left to DeliverTx.
+// Verification consumes gas from the transaction gas meter, so it's limited by the transaction gas limit.
+// Verified signatures are remembered by the keeper, so DeliverTx doesn't verify them twice.
+type IdentitySigVerificationDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewIdentitySigVerificationDecorator(k keeper.Keeper) IdentitySigVerificationDecorator {
+	return IdentitySigVerificationDecorator{
+		keeper: k,
+	}
+}
+
+func (svd IdentitySigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Messages are verified by the msg server anyway during DeliverTx and simulation
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range GetIdentityMsgs(tx.GetMsgs()) {
+		err := svd.keeper.VerifyMsgSignatures(ctx, msg)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GetIdentityMsgs returns messages that carry identity signatures, including messages wrapped into authz MsgExec
+func GetIdentityMsgs(msgs []sdk.Msg) []sdk.Msg {
+	var res []sdk.Msg
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgCreateDid, *types.MsgUpdateDid, *types.MsgPatchDid, *types.MsgCreateDidBatch,
+			*types.MsgCreateSchema, *types.MsgCreateCredDef,
+			*types.MsgCreateRevocRegDef, *types.MsgUpdateRevocRegDef, *types.MsgCreateRevocRegEntry, *types.MsgUpdateRevocRegEntry,
+			*types.MsgCreateStatusList, *types.MsgUpdateStatusList, *types.MsgCreateResource,
+			*types.MsgClaimPayment:
+			res = append(res, msg)
+
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil {
+				// Will be rejected by the authz message handler
+				continue
+			}
+
+			res = append(res, GetIdentityMsgs(innerMsgs)...)
+		}
+	}
+
+	return res
+}
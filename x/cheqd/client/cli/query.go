@@ -20,6 +20,25 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdGetDid())
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryFeeSchedule())
+	cmd.AddCommand(CmdQueryNamespaces())
+	cmd.AddCommand(CmdQueryTrustedIssuersByIssuer())
+	cmd.AddCommand(CmdQueryTrustedIssuersByCredentialType())
+	cmd.AddCommand(CmdQueryIdStatus())
+	cmd.AddCommand(CmdGetSchema())
+	cmd.AddCommand(CmdGetCredDef())
+	cmd.AddCommand(CmdQueryCredDefsByIssuer())
+	cmd.AddCommand(CmdGetRevocRegDef())
+	cmd.AddCommand(CmdGetRevocRegEntry())
+	cmd.AddCommand(CmdGetRevocRegEntryAtTime())
+	cmd.AddCommand(CmdGetStatusList())
+	cmd.AddCommand(CmdGetStatusListIndex())
+	cmd.AddCommand(CmdGetResource())
+	cmd.AddCommand(CmdQueryCollectionResources())
+	cmd.AddCommand(CmdDereferenceResource())
+	cmd.AddCommand(CmdGetEscrow())
+	cmd.AddCommand(CmdGetPaymentReceipt())
 
 	return cmd
 }
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetCredDef() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cred-def [id]",
+		Short: "Query a credential definition",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id := args[0]
+			params := &types.QueryGetCredDefRequest{
+				Id: id,
+			}
+
+			resp, err := queryClient.CredDef(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCredDefsByIssuer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cred-defs-by-issuer [issuer]",
+		Short: "Query credential definitions created by the issuer DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCredDefsByIssuerRequest{
+				Issuer:     args[0],
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.CredDefsByIssuer(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cred-defs-by-issuer")
+
+	return cmd
+}
@@ -35,5 +35,8 @@ func CmdGetDid() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 
+	cmd.AddCommand(CmdGetDidHistory())
+	cmd.AddCommand(CmdGetDidDiff())
+
 	return cmd
 }
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetDidDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [id] [from-version] [to-version]",
+		Short: "Query the changes between two versions of a did",
+		Long: "Lists verification methods, services and other entries added (+), removed (-) and changed (~) between two versions of a did. " +
+			"Use --output json to get the structured change set.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDidDiffRequest{
+				Id:          args[0],
+				FromVersion: args[1],
+				ToVersion:   args[2],
+			}
+
+			resp, err := queryClient.DidDiff(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == "json" {
+				return clientCtx.PrintProto(resp)
+			}
+
+			return clientCtx.PrintString(formatDidDiff(*resp.Diff))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func formatDidDiff(diff types.DidDiff) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%s: %s -> %s\n", diff.Id, diff.FromVersionId, diff.ToVersionId))
+
+	if diff.IsEmpty() {
+		sb.WriteString("no changes\n")
+		return sb.String()
+	}
+
+	lists := []struct {
+		name string
+		diff *types.DidListDiff
+	}{
+		{types.PatchFieldContext, diff.Context},
+		{types.PatchFieldController, diff.Controller},
+		{types.PatchFieldAuthentication, diff.Authentication},
+		{types.PatchFieldAssertionMethod, diff.AssertionMethod},
+		{types.PatchFieldCapabilityInvocation, diff.CapabilityInvocation},
+		{types.PatchFieldCapabilityDelegation, diff.CapabilityDelegation},
+		{types.PatchFieldKeyAgreement, diff.KeyAgreement},
+		{types.PatchFieldAlsoKnownAs, diff.AlsoKnownAs},
+	}
+
+	writeDidEntryDiff(&sb, types.PatchFieldVerificationMethod, diff.VerificationMethod)
+
+	for _, list := range lists {
+		if list.diff.IsEmpty() {
+			continue
+		}
+
+		writeDidEntryDiff(&sb, list.name, &types.DidEntryDiff{Added: list.diff.Added, Removed: list.diff.Removed})
+	}
+
+	writeDidEntryDiff(&sb, types.PatchFieldService, diff.Service)
+
+	return sb.String()
+}
+
+func writeDidEntryDiff(sb *strings.Builder, name string, diff *types.DidEntryDiff) {
+	if diff.IsEmpty() {
+		return
+	}
+
+	sb.WriteString(name + ":\n")
+
+	for _, change := range []struct {
+		sign   string
+		values []string
+	}{
+		{"+", diff.Added},
+		{"-", diff.Removed},
+		{"~", diff.Changed},
+	} {
+		for _, value := range change.values {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", change.sign, value))
+		}
+	}
+}
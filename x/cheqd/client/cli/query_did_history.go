@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const didHistoryPageSize = 100
+
+// DidHistoryEntry is a single DID Doc lifecycle event found by the `did history` command
+type DidHistoryEntry struct {
+	Height    int64           `json:"height"`
+	TxHash    string          `json:"txhash,omitempty"`
+	Timestamp string          `json:"timestamp,omitempty"`
+	Type      string          `json:"type"`
+	Event     json.RawMessage `json:"event"`
+}
+
+func CmdGetDidHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [id]",
+		Short: "Query the history of a did",
+		Long: "Lists creation, update and deactivation events of a did in the order they happened. " +
+			"Creations and updates are found by tx search and deactivations by block search, " +
+			"so the node must index the corresponding events.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			did := args[0]
+
+			var entries []DidHistoryEntry
+
+			for _, eventType := range []string{
+				proto.MessageName(&types.EventDidCreated{}),
+				proto.MessageName(&types.EventDidUpdated{}),
+			} {
+				txEntries, err := queryDidTxEvents(clientCtx, eventType, did)
+				if err != nil {
+					return err
+				}
+
+				entries = append(entries, txEntries...)
+			}
+
+			blockEntries, err := queryDidBlockEvents(clientCtx, proto.MessageName(&types.EventDidDeactivated{}), did)
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, blockEntries...)
+
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].Height < entries[j].Height
+			})
+
+			out, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func didEventQuery(eventType string, did string) string {
+	// Attribute values of typed events are JSON encoded
+	return fmt.Sprintf("%s.id='\"%s\"'", eventType, did)
+}
+
+func queryDidTxEvents(clientCtx client.Context, eventType string, did string) ([]DidHistoryEntry, error) {
+	var entries []DidHistoryEntry
+
+	for page := 1; ; page++ {
+		res, err := authtx.QueryTxsByEvents(clientCtx, []string{didEventQuery(eventType, did)}, page, didHistoryPageSize, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range res.Txs {
+			txEntries, err := filterDidEvents(clientCtx, tx.Events, eventType, did)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, entry := range txEntries {
+				entry.Height = tx.Height
+				entry.TxHash = tx.TxHash
+				entry.Timestamp = tx.Timestamp
+				entries = append(entries, entry)
+			}
+		}
+
+		if uint64(page) >= res.PageTotal {
+			return entries, nil
+		}
+	}
+}
+
+func queryDidBlockEvents(clientCtx client.Context, eventType string, did string) ([]DidHistoryEntry, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []DidHistoryEntry
+
+	perPage := didHistoryPageSize
+
+	for page := 1; ; page++ {
+		res, err := node.BlockSearch(context.Background(), didEventQuery(eventType, did), &page, &perPage, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, block := range res.Blocks {
+			height := block.Block.Height
+
+			results, err := node.BlockResults(context.Background(), &height)
+			if err != nil {
+				return nil, err
+			}
+
+			blockEntries, err := filterDidEvents(clientCtx, results.EndBlockEvents, eventType, did)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, entry := range blockEntries {
+				entry.Height = height
+				entry.Timestamp = block.Block.Time.Format(time.RFC3339)
+				entries = append(entries, entry)
+			}
+		}
+
+		if page*perPage >= res.TotalCount {
+			return entries, nil
+		}
+	}
+}
+
+func filterDidEvents(clientCtx client.Context, events []abci.Event, eventType string, did string) ([]DidHistoryEntry, error) {
+	var entries []DidHistoryEntry
+
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		// A single tx can contain events of several DIDs
+		if msg.(interface{ GetId() string }).GetId() != did {
+			continue
+		}
+
+		bz, err := clientCtx.Codec.MarshalJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, DidHistoryEntry{
+			Type:  eventType,
+			Event: bz,
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow [issuer-did] [holder-address] [request-id]",
+		Short: "Query the payment the holder locked for a credential request to the issuer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetEscrowRequest{
+				Issuer:    args[0],
+				Holder:    args[1],
+				RequestId: args[2],
+			}
+
+			resp, err := queryClient.Escrow(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetPaymentReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payment-receipt [request-id]",
+		Short: "Query bank sends made with the payment request id in the memo",
+		Long: "Returns receipts of bank sends whose transaction memo is the payment request id, ordered by height and paginated. " +
+			"Each receipt contains the payer, the payee, the amount, the height and the hash of the transaction. " +
+			"Issuers must check that the payee and the amount match the payment request.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryPaymentReceiptRequest{
+				RequestId:  args[0],
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.PaymentReceipt(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payment-receipt")
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource [collection-id] [id]",
+		Short: "Query a DID-linked resource",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetResourceRequest{
+				CollectionId: args[0],
+				Id:           args[1],
+			}
+
+			resp, err := queryClient.Resource(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCollectionResources() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collection-resources [collection-id]",
+		Short: "Query headers of the resources linked to the DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCollectionResourcesRequest{
+				CollectionId: args[0],
+				Pagination:   pageReq,
+			}
+
+			resp, err := queryClient.CollectionResources(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collection-resources")
+
+	return cmd
+}
+
+func CmdDereferenceResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dereference-resource [did-url]",
+		Short: "Query the resource a DID URL of the form did:cheqd:<namespace>:<id>/resources/<uuid> points to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDereferenceResourceRequest{
+				DidUrl: args[0],
+			}
+
+			resp, err := queryClient.DereferenceResource(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetRevocRegDef() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoc-reg-def [id]",
+		Short: "Query a revocation registry definition",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRevocRegDefRequest{
+				Id: args[0],
+			}
+
+			resp, err := queryClient.RevocRegDef(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetRevocRegEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoc-reg-entry [revoc-reg-def-id]",
+		Short: "Query the latest entry of a revocation registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRevocRegEntryRequest{
+				RevocRegDefId: args[0],
+			}
+
+			resp, err := queryClient.RevocRegEntry(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetRevocRegEntryAtTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoc-reg-entry-at-time [revoc-reg-def-id] [unix-timestamp]",
+		Short: "Query the entry of a revocation registry that was the latest at the given time",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			timestamp, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRevocRegEntryAtTimeRequest{
+				RevocRegDefId: args[0],
+				Timestamp:     timestamp,
+			}
+
+			resp, err := queryClient.RevocRegEntryAtTime(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
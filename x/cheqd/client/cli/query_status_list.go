@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGetStatusList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status-list [id]",
+		Short: "Query a status list with its GZIP-compressed, base64 encoded bitstring",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetStatusListRequest{
+				Id: args[0],
+			}
+
+			resp, err := queryClient.StatusList(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetStatusListIndex() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status-list-index [id] [index]",
+		Short: "Check whether a bit of a status list is set",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetStatusListIndexRequest{
+				Id:    args[0],
+				Index: index,
+			}
+
+			resp, err := queryClient.StatusListIndex(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryTrustedIssuersByIssuer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trusted-issuers-by-issuer [issuer]",
+		Short: "Query credential types the issuer DID is accredited for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryTrustedIssuersByIssuerRequest{
+				Issuer:     args[0],
+				Pagination: pageReq,
+			}
+
+			resp, err := queryClient.TrustedIssuersByIssuer(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trusted-issuers-by-issuer")
+
+	return cmd
+}
+
+func CmdQueryTrustedIssuersByCredentialType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trusted-issuers-by-type [credential-type]",
+		Short: "Query issuer DIDs accredited for the credential type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryTrustedIssuersByCredentialTypeRequest{
+				CredentialType: args[0],
+				Pagination:     pageReq,
+			}
+
+			resp, err := queryClient.TrustedIssuersByCredentialType(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trusted-issuers-by-type")
+
+	return cmd
+}
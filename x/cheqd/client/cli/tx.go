@@ -7,14 +7,28 @@ import (
 	"fmt"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagSignatureExpiryHeight = "signature-expiry-height"
+	FlagSignBytesMode         = "sign-bytes-mode"
+)
+
+var signBytesModes = map[string]types.SignBytesMode{
+	"proto": types.SignBytesMode_SIGN_BYTES_MODE_PROTO,
+	"jcs":   types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+}
+
 type SignInput struct {
 	verificationMethodId string
 	privKey              ed25519.PrivateKey
@@ -32,6 +46,20 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreateDid())
 	cmd.AddCommand(CmdUpdateDid())
+	cmd.AddCommand(CmdPatchDid())
+	cmd.AddCommand(CmdCreateDidBatch())
+	cmd.AddCommand(CmdCreateSchema())
+	cmd.AddCommand(CmdCreateCredDef())
+	cmd.AddCommand(CmdCreateRevocRegDef())
+	cmd.AddCommand(CmdUpdateRevocRegDef())
+	cmd.AddCommand(CmdCreateRevocRegEntry())
+	cmd.AddCommand(CmdUpdateRevocRegEntry())
+	cmd.AddCommand(CmdCreateStatusList())
+	cmd.AddCommand(CmdUpdateStatusList())
+	cmd.AddCommand(CmdCreateResource())
+	cmd.AddCommand(CmdLockPayment())
+	cmd.AddCommand(CmdClaimPayment())
+	cmd.AddCommand(CmdReclaimPayment())
 
 	return cmd
 }
@@ -79,7 +107,44 @@ func GetPayloadAndSignInputs(clientCtx client.Context, args []string) (string, [
 	return payloadJson, signInputs, nil
 }
 
-func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignInfo {
+// AddSignFlagsToCmd adds flags that control identity signatures
+func AddSignFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagSignatureExpiryHeight, 0, "Last block height at which identity signatures are valid. 0 means no expiry")
+	cmd.Flags().String(FlagSignBytesMode, "proto", "How the payload is serialised for signing: proto or jcs (RFC 8785 canonical JSON)")
+}
+
+// SignMsg signs the identity message payload bound to the chain id, the DID namespace and the message type
+func SignMsg(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg, did string, payload types.IdentityMsg, signInputs []SignInput) ([]*types.SignInfo, error) {
+	_, namespace, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		return nil, err
+	}
+
+	expiryHeight, err := cmd.Flags().GetUint64(FlagSignatureExpiryHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	modeStr, err := cmd.Flags().GetString(FlagSignBytesMode)
+	if err != nil {
+		return nil, err
+	}
+
+	mode, found := signBytesModes[modeStr]
+	if !found {
+		return nil, fmt.Errorf("unsupported sign bytes mode: %s", modeStr)
+	}
+
+	signPayload := types.NewSignPayloadForMode(clientCtx.ChainID, namespace, sdk.MsgTypeURL(msg), payload, mode)
+	signBytes, err := signPayload.GetSignBytes(types.SignDocVersion1, mode, expiryHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return SignWithSignInputs(signBytes, types.SignDocVersion1, mode, expiryHeight, signInputs), nil
+}
+
+func SignWithSignInputs(signBytes []byte, signDocVersion uint32, mode types.SignBytesMode, expiryHeight uint64, signInputs []SignInput) []*types.SignInfo {
 	var signatures []*types.SignInfo
 
 	for _, signInput := range signInputs {
@@ -88,6 +153,9 @@ func SignWithSignInputs(signBytes []byte, signInputs []SignInput) []*types.SignI
 		signInfo := types.SignInfo{
 			VerificationMethodId: signInput.verificationMethodId,
 			Signature:            base64.StdEncoding.EncodeToString(signatureBytes),
+			SignDocVersion:       signDocVersion,
+			ExpiryHeight:         expiryHeight,
+			SignBytesMode:        mode,
 		}
 
 		signatures = append(signatures, &signInfo)
@@ -126,3 +194,23 @@ func AccAddrByKeyRef(keyring keyring.Keyring, keyRef string) (sdk.AccAddress, er
 	// Fallback: convert keyref to address
 	return sdk.AccAddressFromBech32(keyRef)
 }
+
+// submitProposal submits a governance proposal with the deposit from the flags
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitAddTrustedIssuersProposal implements the command to submit an add-trusted-issuers governance proposal
+func CmdSubmitAddTrustedIssuersProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-trusted-issuers [proposal-file]",
+		Short: "Submit a proposal to accredit issuer DIDs for credential types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add entries to the trusted issuer registry or replace them along with an initial deposit.
+Issuer DIDs must exist and must not be deactivated.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal add-trusted-issuers <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Accredit the university",
+  "description": "The university may issue diplomas",
+  "trusted_issuers": [
+    {
+      "issuer": "did:cheqd:mainnet:zAbCdEfGhIjKlMnO",
+      "credential_type": "UniversityDegreeCredential",
+      "valid_from": "2022-01-01T00:00:00Z",
+      "valid_until": "2027-01-01T00:00:00Z"
+    }
+  ]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.AddTrustedIssuersProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdClaimPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-payment [payload-json] [ver-method-id-1] [priv-key-1] [ver-method-id-N] [priv-key-N] ...",
+		Short: "Claims a payment locked for a credential request.",
+		Long: "Sends the payment locked for the credential request to the recipient. The issuer DID must sign the claim with one of its assertion methods. " +
+			"[payload-json] is JSON encoded MsgClaimPaymentPayload. " +
+			"[ver-method-id-N] is the DID fragment that points to the public part of the key in the ledger for the signature N." +
+			"[priv-key-1] is base base64 encoded ed25519 private key for signature N." +
+			"If 'interactive' value is used for a key, the key will be read interactively. " +
+			"Prefer interactive mode, use inline mode only for tests.",
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJson, signInputs, err := GetPayloadAndSignInputs(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			if len(signInputs) == 0 {
+				return errors.New("an assertion method signature of the issuer is required")
+			}
+
+			// Unmarshal payload
+			var payload types.MsgClaimPaymentPayload
+			err = clientCtx.Codec.UnmarshalJSON([]byte(payloadJson), &payload)
+			if err != nil {
+				return err
+			}
+
+			// The payload doesn't contain the issuer DID, take it from the verification method
+			issuer, _, _, _, err := utils.TrySplitDIDUrl(signInputs[0].verificationMethodId)
+			if err != nil {
+				return err
+			}
+
+			// Build identity message
+			msg := types.MsgClaimPayment{
+				Payload: &payload,
+			}
+
+			msg.Signatures, err = SignMsg(cmd, clientCtx, &msg, issuer, &payload, signInputs)
+			if err != nil {
+				return err
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	AddSignFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const FlagResourceFile = "resource-file"
+
+func CmdCreateResource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-resource [payload-json] [ver-method-id-1] [priv-key-1] [ver-method-id-N] [priv-key-N] ...",
+		Short: "Creates a new DID-linked resource.",
+		Long: "Creates a new immutable resource in the collection of a DID. A resource with the same name and resource type becomes its previous version. " +
+			"The controllers of the DID must sign it. The content can be passed base64 encoded in the data field or read from --resource-file. " +
+			"[payload-json] is JSON encoded MsgCreateResourcePayload. " +
+			"[ver-method-id-N] is the DID fragment that points to the public part of the key in the ledger for the signature N." +
+			"[priv-key-1] is base base64 encoded ed25519 private key for signature N." +
+			"If 'interactive' value is used for a key, the key will be read interactively. " +
+			"Prefer interactive mode, use inline mode only for tests.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payloadJson, signInputs, err := GetPayloadAndSignInputs(clientCtx, args)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal payload
+			var payload types.MsgCreateResourcePayload
+			err = clientCtx.Codec.UnmarshalJSON([]byte(payloadJson), &payload)
+			if err != nil {
+				return err
+			}
+
+			resourceFile, err := cmd.Flags().GetString(FlagResourceFile)
+			if err != nil {
+				return err
+			}
+
+			if resourceFile != "" {
+				payload.Data, err = ioutil.ReadFile(resourceFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Build identity message
+			msg := types.MsgCreateResource{
+				Payload: &payload,
+			}
+
+			msg.Signatures, err = SignMsg(cmd, clientCtx, &msg, payload.CollectionId, &payload, signInputs)
+			if err != nil {
+				return err
+			}
+
+			// Set fee-payer if not set
+			err = SetFeePayerFromSigner(&clientCtx)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagResourceFile, "", "Path to the file with the resource content")
+	AddSignFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitDeactivateDidProposal implements the command to submit a deactivate-did governance proposal
+func CmdSubmitDeactivateDidProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deactivate-did [proposal-file]",
+		Short: "Submit a proposal to deactivate a DID Doc and redact its content",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to deactivate a DID Doc publishing illegal or abusive content along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal deactivate-did <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Deactivate an abusive DID",
+  "description": "The DID Doc links to illegal content",
+  "did": "did:cheqd:mainnet:zAbCdEfGhIjKlMnO",
+  "redacted_fields": ["service", "alsoKnownAs"]
+}
+
+Supported redacted fields: service, alsoKnownAs.
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.DeactivateDidProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
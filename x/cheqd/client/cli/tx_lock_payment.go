@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdLockPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-payment [request-id] [issuer-did] [amount] [timeout]",
+		Short: "Locks a payment for a credential request.",
+		Long: "Moves the payment from the --from account to escrow. " +
+			"The issuer can claim it by signing the claim with one of its assertion methods, the holder can reclaim it after the timeout. " +
+			"[amount] is the payment in ncheq, e.g. 1000ncheq. " +
+			"[timeout] is the number of seconds after which the payment can be reclaimed, from one hour to one year.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			timeout, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockPayment(clientCtx.GetFromAddress().String(), args[0], args[1], amount, timeout)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
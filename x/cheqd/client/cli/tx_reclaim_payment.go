@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+func CmdReclaimPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reclaim-payment [request-id] [issuer-did]",
+		Short: "Returns an expired payment to the holder.",
+		Long:  "Returns the payment locked for the credential request to the issuer to the --from account, which must be the account that locked it.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReclaimPayment(clientCtx.GetFromAddress().String(), args[0], args[1])
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitReleaseReservedIdsProposal implements the command to submit a release-reserved-ids governance proposal
+func CmdSubmitReleaseReservedIdsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-reserved-ids [proposal-file]",
+		Short: "Submit a proposal to make reserved or blocked DIDs available",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to make reserved or blocked DIDs available to anyone along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal release-reserved-ids <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Release the DID of the foundation",
+  "description": "The foundation doesn't need the DID anymore",
+  "dids": ["did:cheqd:mainnet:zAbCdEfGhIjKlMnO"]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.ReleaseReservedIdsProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
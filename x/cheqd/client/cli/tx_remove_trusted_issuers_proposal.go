@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitRemoveTrustedIssuersProposal implements the command to submit a remove-trusted-issuers governance proposal
+func CmdSubmitRemoveTrustedIssuersProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-trusted-issuers [proposal-file]",
+		Short: "Submit a proposal to revoke accreditations of issuer DIDs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove entries from the trusted issuer registry along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal remove-trusted-issuers <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Revoke the accreditation of the university",
+  "description": "The university may not issue diplomas anymore",
+  "trusted_issuers": [
+    {
+      "issuer": "did:cheqd:mainnet:zAbCdEfGhIjKlMnO",
+      "credential_type": "UniversityDegreeCredential"
+    }
+  ]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.RemoveTrustedIssuersProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitSetNamespacesProposal implements the command to submit a set-namespaces governance proposal
+func CmdSubmitSetNamespacesProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-namespaces [proposal-file]",
+		Short: "Submit a proposal to add DID namespaces or change their status",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add DID namespaces or change their status along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-namespaces <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Retire the testnet namespace",
+  "description": "The testnet namespace is not used anymore",
+  "namespaces": [
+    {
+      "name": "testnet",
+      "status": "NAMESPACE_STATUS_RETIRED"
+    }
+  ]
+}
+
+Set "self_certifying_ids" to true to require unique ids of new DIDs to be derived from their initial authentication key.
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.SetNamespacesProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitSetReservedIdsProposal implements the command to submit a set-reserved-ids governance proposal
+func CmdSubmitSetReservedIdsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reserved-ids [proposal-file]",
+		Short: "Submit a proposal to reserve or block DIDs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to reserve or block DIDs along with an initial deposit.
+Reserved DIDs can be created only with a signature of the allowed creator key. Blocked DIDs can't be created.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-reserved-ids <path/to/proposal.json> --deposit=1000ncheq --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Reserve the DID of the foundation",
+  "description": "The DID is migrated from Indy",
+  "reserved_ids": [
+    {
+      "did": "did:cheqd:mainnet:zAbCdEfGhIjKlMnO",
+      "status": "RESERVATION_STATUS_RESERVED",
+      "allowed_creator_key": "zF1hVGXXK9rmx5HhMTpGnGQJiab9qrFJbQXBRhSmYjQWX"
+    },
+    {
+      "did": "did:cheqd:mainnet:zPqRsTuVwXyZaBcD",
+      "status": "RESERVATION_STATUS_BLOCKED"
+    }
+  ]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.SetReservedIdsProposal
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &content); err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, &content)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
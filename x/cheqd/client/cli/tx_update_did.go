@@ -38,12 +38,13 @@ func CmdUpdateDid() *cobra.Command {
 			}
 
 			// Build identity message
-			signBytes := payload.GetSignBytes()
-			identitySignatures := SignWithSignInputs(signBytes, signInputs)
-
 			msg := types.MsgUpdateDid{
-				Payload:    &payload,
-				Signatures: identitySignatures,
+				Payload: &payload,
+			}
+
+			msg.Signatures, err = SignMsg(cmd, clientCtx, &msg, payload.Id, &payload, signInputs)
+			if err != nil {
+				return err
 			}
 
 			// Set fee-payer if not set
@@ -56,6 +57,7 @@ func CmdUpdateDid() *cobra.Command {
 		},
 	}
 
+	AddSignFlagsToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,22 @@
+package client
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/client/cli"
+	"github.com/cheqd/cheqd-node/x/cheqd/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	// SetNamespacesProposalHandler is the DID namespace registry proposal handler
+	SetNamespacesProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetNamespacesProposal, rest.SetNamespacesProposalRESTHandler)
+	// DeactivateDidProposalHandler is the DID Doc deactivation proposal handler
+	DeactivateDidProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitDeactivateDidProposal, rest.DeactivateDidProposalRESTHandler)
+	// AddTrustedIssuersProposalHandler is the trusted issuer accreditation proposal handler
+	AddTrustedIssuersProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitAddTrustedIssuersProposal, rest.AddTrustedIssuersProposalRESTHandler)
+	// RemoveTrustedIssuersProposalHandler is the trusted issuer revocation proposal handler
+	RemoveTrustedIssuersProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitRemoveTrustedIssuersProposal, rest.RemoveTrustedIssuersProposalRESTHandler)
+	// SetReservedIdsProposalHandler is the DID reservation proposal handler
+	SetReservedIdsProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitSetReservedIdsProposal, rest.SetReservedIdsProposalRESTHandler)
+	// ReleaseReservedIdsProposalHandler is the DID reservation release proposal handler
+	ReleaseReservedIdsProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitReleaseReservedIdsProposal, rest.ReleaseReservedIdsProposalRESTHandler)
+)
@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// Proposal REST handlers are stubs, as legacy REST routes are not supported for cheqd proposals.
+// Use the CLI or gRPC gateway instead.
+
+func SetNamespacesProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_namespaces",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func DeactivateDidProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "deactivate_did",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func AddTrustedIssuersProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_trusted_issuers",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func RemoveTrustedIssuersProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_trusted_issuers",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func SetReservedIdsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_reserved_ids",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func ReleaseReservedIdsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "release_reserved_ids",
+		Handler:  notSupportedHandler,
+	}
+}
+
+func notSupportedHandler(w http.ResponseWriter, _ *http.Request) {
+	rest.WriteErrorResponse(w, http.StatusBadRequest, "legacy REST routes are not supported for cheqd proposals")
+}
@@ -25,7 +25,120 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set nym count
 	k.SetDidCount(&ctx, uint64(len(genState.DidList)))
 
-	k.SetDidNamespace(ctx, genState.DidNamespace)
+	for _, elem := range genState.DidVersions {
+		did, err := elem.UnpackDataAsDid()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetDidVersion(&ctx, did, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set did version case: %s", err.Error()))
+		}
+	}
+
+	for _, namespace := range genState.Namespaces {
+		k.SetNamespace(ctx, *namespace)
+	}
+
+	// Genesis files created before the namespace registry was introduced have a single namespace
+	if _, found := k.GetNamespace(ctx, genState.DidNamespace); genState.DidNamespace != "" && !found {
+		k.SetNamespace(ctx, types.NewNamespace(genState.DidNamespace, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
+	}
+
+	for _, trustedIssuer := range genState.TrustedIssuers {
+		k.SetTrustedIssuer(ctx, *trustedIssuer)
+	}
+
+	for _, reservedId := range genState.ReservedIds {
+		k.SetReservedId(ctx, *reservedId)
+	}
+
+	for _, elem := range genState.Schemas {
+		schema, err := elem.UnpackDataAsSchema()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetSchema(ctx, schema, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set schema case: %s", err.Error()))
+		}
+	}
+
+	for _, elem := range genState.CredDefs {
+		credDef, err := elem.UnpackDataAsCredDef()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetCredDef(ctx, credDef, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set cred def case: %s", err.Error()))
+		}
+	}
+
+	for _, elem := range genState.RevocRegDefs {
+		revocRegDef, err := elem.UnpackDataAsRevocRegDef()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetRevocRegDef(ctx, revocRegDef, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set revoc reg def case: %s", err.Error()))
+		}
+	}
+
+	for _, elem := range genState.RevocRegEntries {
+		entry, err := elem.UnpackDataAsRevocRegEntry()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetRevocRegEntry(ctx, entry, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set revoc reg entry case: %s", err.Error()))
+		}
+	}
+
+	for _, entry := range genState.RevocRegEntryHistory {
+		k.AppendRevocRegEntryHistory(ctx, *entry)
+	}
+
+	for _, elem := range genState.StatusLists {
+		statusList, err := elem.UnpackDataAsStatusList()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetStatusList(ctx, statusList, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set status list case: %s", err.Error()))
+		}
+	}
+
+	for _, chunk := range genState.StatusListChunks {
+		k.SetStatusListChunk(ctx, *chunk)
+	}
+
+	for _, elem := range genState.Resources {
+		resource, err := elem.UnpackDataAsResource()
+		if err != nil {
+			panic(fmt.Sprintf("Cannot import geneses case: %s", err.Error()))
+		}
+
+		if err = k.SetResource(ctx, resource, elem.Metadata); err != nil {
+			panic(fmt.Sprintf("Cannot set resource case: %s", err.Error()))
+		}
+	}
+
+	// Locked payments are held by the escrow module account, its balance is imported by the bank module
+	for _, escrow := range genState.Escrows {
+		k.SetEscrow(ctx, *escrow)
+	}
+
+	for _, receipt := range genState.PaymentReceipts {
+		k.AppendPaymentReceipt(ctx, *receipt)
+	}
+
+	if genState.Params != nil {
+		k.SetParams(ctx, *genState.Params)
+	}
 }
 
 // ExportGenesis returns the cheqd module's exported genesis.
@@ -40,7 +153,80 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.DidList = append(genesis.DidList, &elem)
 	}
 
-	genesis.DidNamespace = k.GetDidNamespace(ctx)
+	genesis.DidNamespace = ""
+	genesis.Namespaces = nil
+	for _, namespace := range k.GetAllNamespaces(ctx) {
+		namespace := namespace
+		genesis.Namespaces = append(genesis.Namespaces, &namespace)
+	}
+
+	for _, trustedIssuer := range k.GetAllTrustedIssuers(ctx) {
+		trustedIssuer := trustedIssuer
+		genesis.TrustedIssuers = append(genesis.TrustedIssuers, &trustedIssuer)
+	}
+
+	for _, reservedId := range k.GetAllReservedIds(ctx) {
+		reservedId := reservedId
+		genesis.ReservedIds = append(genesis.ReservedIds, &reservedId)
+	}
+
+	for _, schema := range k.GetAllSchemas(ctx) {
+		schema := schema
+		genesis.Schemas = append(genesis.Schemas, &schema)
+	}
+
+	for _, credDef := range k.GetAllCredDefs(ctx) {
+		credDef := credDef
+		genesis.CredDefs = append(genesis.CredDefs, &credDef)
+	}
+
+	for _, revocRegDef := range k.GetAllRevocRegDefs(ctx) {
+		revocRegDef := revocRegDef
+		genesis.RevocRegDefs = append(genesis.RevocRegDefs, &revocRegDef)
+	}
+
+	for _, entry := range k.GetAllRevocRegEntries(ctx) {
+		entry := entry
+		genesis.RevocRegEntries = append(genesis.RevocRegEntries, &entry)
+	}
+
+	for _, entry := range k.GetAllRevocRegEntryHistory(ctx) {
+		entry := entry
+		genesis.RevocRegEntryHistory = append(genesis.RevocRegEntryHistory, &entry)
+	}
+
+	for _, statusList := range k.GetAllStatusLists(ctx) {
+		statusList := statusList
+		genesis.StatusLists = append(genesis.StatusLists, &statusList)
+	}
+
+	for _, chunk := range k.GetAllStatusListChunks(ctx) {
+		chunk := chunk
+		genesis.StatusListChunks = append(genesis.StatusListChunks, &chunk)
+	}
+
+	for _, resource := range k.GetAllResources(ctx) {
+		resource := resource
+		genesis.Resources = append(genesis.Resources, &resource)
+	}
+
+	for _, escrow := range k.GetAllEscrows(ctx) {
+		escrow := escrow
+		genesis.Escrows = append(genesis.Escrows, &escrow)
+	}
+
+	for _, receipt := range k.GetAllPaymentReceipts(ctx) {
+		receipt := receipt
+		genesis.PaymentReceipts = append(genesis.PaymentReceipts, &receipt)
+	}
+
+	for _, version := range k.GetAllDidVersions(&ctx) {
+		version := version
+		genesis.DidVersions = append(genesis.DidVersions, &version)
+	}
+
+	params := k.GetParams(ctx)
+	genesis.Params = &params
 
 	return genesis
 }
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitDidCreatedEvent emits the typed event of a DID Doc creation
+func EmitDidCreatedEvent(ctx sdk.Context, did types.Did, metadata types.Metadata) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDidCreated{
+		Id:        did.Id,
+		VersionId: metadata.VersionId,
+		Signers:   GetSignerDIDsForDIDCreation(did),
+	})
+}
+
+// EmitDidUpdatedEvent emits the typed event of a DID Doc update with the summary of changes
+func EmitDidUpdatedEvent(ctx sdk.Context, existingDid types.Did, existingMetadata types.Metadata, updatedDid types.Did, updatedMetadata types.Metadata) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDidUpdated{
+		Id:                updatedDid.Id,
+		VersionId:         updatedMetadata.VersionId,
+		PreviousVersionId: existingMetadata.VersionId,
+		Signers:           GetSignerDIDsForDIDUpdate(existingDid, updatedDid),
+		ChangedFields:     types.NewDidDiff(existingDid, existingMetadata.VersionId, updatedDid, updatedMetadata.VersionId).ChangedFields(),
+	})
+}
+
+// EmitDidDeactivatedEvent emits the typed event of a DID Doc deactivation by governance
+func EmitDidDeactivatedEvent(ctx sdk.Context, did types.Did, existingMetadata types.Metadata, metadata types.Metadata) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDidDeactivated{
+		Id:                did.Id,
+		VersionId:         metadata.VersionId,
+		ProposalId:        metadata.DeactivationProposalId,
+		RedactedFields:    metadata.RedactedFields,
+		PreviousVersionId: existingMetadata.VersionId,
+	})
+}
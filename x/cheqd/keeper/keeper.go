@@ -8,19 +8,31 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
 	Keeper struct {
-		cdc      codec.BinaryCodec
-		storeKey sdk.StoreKey
+		cdc        codec.BinaryCodec
+		storeKey   sdk.StoreKey
+		paramSpace paramtypes.Subspace
+		bankKeeper types.BankKeeper
+		sigCache   *SignatureCache
 	}
 )
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, bankKeeper types.BankKeeper) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace,
+		bankKeeper: bankKeeper,
+		sigCache:   NewSignatureCache(DefaultSignatureCacheSize),
 	}
 }
 
@@ -5,16 +5,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetDidNamespace get did namespace
-func (k Keeper) GetDidNamespace(ctx sdk.Context) string {
+// GetLegacyDidNamespace returns the single did namespace stored before the namespace registry was introduced
+func (k Keeper) GetLegacyDidNamespace(ctx sdk.Context) string {
 	return k.GetFromState(ctx, types.DidNamespaceKey)
 }
 
-// SetDidNamespace set did namespace
-func (k Keeper) SetDidNamespace(ctx sdk.Context, namespace string) {
-	k.SetToState(ctx, types.DidNamespaceKey, []byte(namespace))
-}
-
 // GetDidNamespace - get State value
 func (k Keeper) GetFromState(ctx sdk.Context, stateKey string) string {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetCredDef set a specific credential definition in the store and indexes it by its controllers
+func (k Keeper) SetCredDef(ctx sdk.Context, credDef *types.CredDef, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(credDef, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(GetDidIDBytes(credDef.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefByIssuerKey))
+	for _, issuer := range credDef.Controller {
+		indexStore.Set(GetCredDefByIssuerKeyBytes(issuer, credDef.Id), []byte{})
+	}
+
+	return nil
+}
+
+// GetCredDef returns a credential definition from its id
+func (k Keeper) GetCredDef(ctx sdk.Context, id string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefKey))
+
+	bytes := store.Get(GetDidIDBytes(id))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrCredDefNotFound.Wrap(id)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasCredDef checks if the credential definition exists in the store
+func (k Keeper) HasCredDef(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefKey))
+	return store.Has(GetDidIDBytes(id))
+}
+
+// GetAllCredDefs returns all credential definitions
+func (k Keeper) GetAllCredDefs(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCredDefByIssuerKeyBytes returns the key of the issuer index entry.
+// DIDs can't contain slashes, so entries of an issuer share the prefix.
+func GetCredDefByIssuerKeyBytes(issuer, id string) []byte {
+	return append(GetCredDefByIssuerPrefixBytes(issuer), []byte(id)...)
+}
+
+func GetCredDefByIssuerPrefixBytes(issuer string) []byte {
+	return []byte(issuer + "/")
+}
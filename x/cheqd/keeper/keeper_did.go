@@ -52,6 +52,9 @@ func (k Keeper) AppendDid(ctx *sdk.Context, did *types.Did, metadata *types.Meta
 		return err
 	}
 
+	// Reservations are consumed by the creation
+	k.RemoveReservedId(*ctx, did.Id)
+
 	// Update did count
 	k.SetDidCount(ctx, count+1)
 	return nil
@@ -67,7 +70,9 @@ func (k Keeper) SetDid(ctx *sdk.Context, did *types.Did, metadata *types.Metadat
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidKey))
 	b := k.cdc.MustMarshal(&stateValue)
 	store.Set(GetDidIDBytes(did.Id), b)
-	return nil
+
+	// Every written state is kept as a version of the did
+	return k.SetDidVersion(ctx, did, metadata)
 }
 
 // GetDid returns a did from its id
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDidVersion stores a did under its version id, so it can be read after the following updates
+func (k Keeper) SetDidVersion(ctx *sdk.Context, did *types.Did, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(did, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey))
+	store.Set(GetDidVersionKeyBytes(did.Id, metadata.VersionId), k.cdc.MustMarshal(&stateValue))
+	return nil
+}
+
+// GetDidVersion returns a specific version of a did.
+// DIDs written before versions were stored only have their latest version.
+func (k Keeper) GetDidVersion(ctx *sdk.Context, id string, versionId string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey))
+
+	bytes := store.Get(GetDidVersionKeyBytes(id, versionId))
+	if bytes == nil {
+		latest, err := k.GetDid(ctx, id)
+		if err != nil || latest.Metadata.VersionId != versionId {
+			return types.StateValue{}, types.ErrDidVersionNotFound.Wrapf("%s, version %s", id, versionId)
+		}
+
+		return latest, nil
+	}
+
+	var value types.StateValue
+	k.cdc.MustUnmarshal(bytes, &value)
+	return value, nil
+}
+
+// RedactDidVersions clears the given fields in all the stored versions of a did
+func (k Keeper) RedactDidVersions(ctx *sdk.Context, id string, fields []string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for _, version := range k.getDidVersions(ctx, id) {
+		did, err := version.UnpackDataAsDid()
+		if err != nil {
+			return err
+		}
+
+		did.Redact(fields)
+
+		if err = k.SetDidVersion(ctx, did, version.Metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getDidVersions(ctx *sdk.Context, id string) []types.StateValue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey))
+	return k.iterateDidVersions(sdk.KVStorePrefixIterator(store, GetDidVersionPrefixBytes(id)))
+}
+
+// GetAllDidVersions returns all versions of all dids
+func (k Keeper) GetAllDidVersions(ctx *sdk.Context) []types.StateValue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey))
+	return k.iterateDidVersions(sdk.KVStorePrefixIterator(store, []byte{}))
+}
+
+func (k Keeper) iterateDidVersions(iterator sdk.Iterator) (list []types.StateValue) {
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetDidVersionKeyBytes returns the key of a did version
+func GetDidVersionKeyBytes(id string, versionId string) []byte {
+	return append(GetDidVersionPrefixBytes(id), []byte(versionId)...)
+}
+
+func GetDidVersionPrefixBytes(id string) []byte {
+	return []byte(id + "/")
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetEscrow set a specific escrow in the store
+func (k Keeper) SetEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKey))
+	b := k.cdc.MustMarshal(&escrow)
+	store.Set(GetEscrowKeyBytes(escrow.Issuer, escrow.Holder, escrow.RequestId), b)
+}
+
+// GetEscrow returns the escrow the holder locked for the credential request to the issuer
+func (k Keeper) GetEscrow(ctx sdk.Context, issuer string, holder string, requestId string) (types.Escrow, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKey))
+
+	bz := store.Get(GetEscrowKeyBytes(issuer, holder, requestId))
+	if bz == nil {
+		return types.Escrow{}, types.ErrEscrowNotFound.Wrapf("issuer: %s, holder: %s, request id: %s", issuer, holder, requestId)
+	}
+
+	var value types.Escrow
+	k.cdc.MustUnmarshal(bz, &value)
+
+	return value, nil
+}
+
+// HasEscrow checks if the holder ever locked a payment for the credential request to the issuer
+func (k Keeper) HasEscrow(ctx sdk.Context, issuer string, holder string, requestId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKey))
+	return store.Has(GetEscrowKeyBytes(issuer, holder, requestId))
+}
+
+// GetAllEscrows returns all escrows
+func (k Keeper) GetAllEscrows(ctx sdk.Context) (list []types.Escrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Escrow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetEscrowKeyBytes returns the key of an escrow.
+// DIDs and addresses can't contain slashes, so request ids can contain any symbols.
+func GetEscrowKeyBytes(issuer string, holder string, requestId string) []byte {
+	return []byte(issuer + "/" + holder + "/" + requestId)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateIdIsUnused checks that the id isn't used by a DID or a ledger object of any type.
+// DIDs, schemas, cred defs, revocation registries and status lists share the same id space,
+// so that an id always dereferences to a single object.
+func (k Keeper) ValidateIdIsUnused(ctx sdk.Context, id string) error {
+	checks := []struct {
+		kind string
+		has  func() bool
+	}{
+		{"DID Doc", func() bool { return k.HasDid(&ctx, id) }},
+		{"schema", func() bool { return k.HasSchema(ctx, id) }},
+		{"credential definition", func() bool { return k.HasCredDef(ctx, id) }},
+		{"revocation registry definition", func() bool { return k.HasRevocRegDef(ctx, id) }},
+		{"status list", func() bool { return k.HasStatusList(ctx, id) }},
+	}
+
+	for _, check := range checks {
+		if check.has() {
+			return types.ErrIdInUse.Wrapf("%s is used by a %s", id, check.kind)
+		}
+	}
+
+	return nil
+}
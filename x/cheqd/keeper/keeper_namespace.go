@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetNamespace adds the namespace to the registry or updates its status
+func (k Keeper) SetNamespace(ctx sdk.Context, namespace types.Namespace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKey))
+	b := k.cdc.MustMarshal(&namespace)
+	store.Set([]byte(namespace.Name), b)
+}
+
+// GetNamespace returns the namespace from the registry.
+// Networks that haven't set up the registry yet get their legacy namespace as the only active one.
+func (k Keeper) GetNamespace(ctx sdk.Context, name string) (types.Namespace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKey))
+
+	bytes := store.Get([]byte(name))
+	if bytes != nil {
+		var namespace types.Namespace
+		k.cdc.MustUnmarshal(bytes, &namespace)
+		return namespace, true
+	}
+
+	if k.hasNamespaces(store) || !ctx.KVStore(k.storeKey).Has(types.KeyPrefix(types.DidNamespaceKey)) {
+		return types.Namespace{}, false
+	}
+
+	legacyName := k.GetLegacyDidNamespace(ctx)
+	if legacyName != name {
+		return types.Namespace{}, false
+	}
+
+	return types.NewNamespace(legacyName, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE), true
+}
+
+func (k Keeper) hasNamespaces(store prefix.Store) bool {
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	return iterator.Valid()
+}
+
+// GetAllNamespaces returns all the namespaces of the registry.
+// Networks that haven't set up the registry yet get their legacy namespace as the only active one.
+func (k Keeper) GetAllNamespaces(ctx sdk.Context) (list []types.Namespace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.NamespaceKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Namespace
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	if len(list) == 0 && ctx.KVStore(k.storeKey).Has(types.KeyPrefix(types.DidNamespaceKey)) {
+		list = append(list, types.NewNamespace(k.GetLegacyDidNamespace(ctx), types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
+	}
+
+	return
+}
+
+// GetRegisteredNamespaceNames returns names of all the namespaces of the registry regardless of their status.
+// DIDs from any of them can be referenced, e.g. as controllers.
+func (k Keeper) GetRegisteredNamespaceNames(ctx sdk.Context) []string {
+	var res []string
+
+	for _, namespace := range k.GetAllNamespaces(ctx) {
+		res = append(res, namespace.Name)
+	}
+
+	return res
+}
+
+// ValidateDidNamespaceIsActive checks that DIDs can be created or updated in the namespace of the DID
+// and returns the namespace
+func (k Keeper) ValidateDidNamespaceIsActive(ctx sdk.Context, did string) (string, error) {
+	_, name, _, err := utils.TrySplitDID(did)
+	if err != nil {
+		return "", types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, found := k.GetNamespace(ctx, name)
+	if !found || !namespace.IsActive() {
+		return "", types.ErrNamespaceValidation.Wrapf("namespace %s is not active", name)
+	}
+
+	return name, nil
+}
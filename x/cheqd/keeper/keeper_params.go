@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams get all parameters as types.Params.
+// Missing parameters fall back to defaults so that networks upgraded from versions without params keep working.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyRequireProofOfPossession, &params.RequireProofOfPossession)
+	k.paramSpace.GetIfExists(ctx, types.KeyLegacySignaturesEndHeight, &params.LegacySignaturesEndHeight)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxVerificationMethods, &params.MaxVerificationMethods)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxServices, &params.MaxServices)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxControllers, &params.MaxControllers)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxPayloadBytes, &params.MaxPayloadBytes)
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowedMethodTypes, &params.AllowedMethodTypes)
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowedServiceTypes, &params.AllowedServiceTypes)
+
+	// Nested messages are registered by value in the key table
+	feeParams := *params.FeeParams
+	k.paramSpace.GetIfExists(ctx, types.KeyFeeParams, &feeParams)
+	params.FeeParams = &feeParams
+
+	gasParams := *params.GasParams
+	k.paramSpace.GetIfExists(ctx, types.KeyGasParams, &gasParams)
+	params.GasParams = &gasParams
+
+	return params
+}
+
+// SetParams set the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ValidateDidParams checks that the DID Doc and the message payload fit into the limits defined by the module params.
+// It also consumes gas proportional to the DID Doc size.
+func (k Keeper) ValidateDidParams(ctx sdk.Context, params types.Params, did types.Did, signPayload types.SignPayload) error {
+	if err := params.ValidatePayloadSize(signPayload.Payload); err != nil {
+		return types.ErrParamsValidation.Wrap(err.Error())
+	}
+
+	if err := params.ValidateDid(&did); err != nil {
+		return types.ErrParamsValidation.Wrap(err.Error())
+	}
+
+	params.GasParams.ConsumeDocumentGas(ctx.GasMeter(), did)
+
+	return nil
+}
+
+// ValidateResourceParams checks that the message payload fits into the limits defined by the module params.
+// It also consumes gas proportional to the resource size.
+func (k Keeper) ValidateResourceParams(ctx sdk.Context, params types.Params, data types.StateValueData, signPayload types.SignPayload) error {
+	if err := params.ValidatePayloadSize(signPayload.Payload); err != nil {
+		return types.ErrParamsValidation.Wrap(err.Error())
+	}
+
+	params.GasParams.ConsumeResourceGas(ctx.GasMeter(), data, "resource size")
+
+	return nil
+}
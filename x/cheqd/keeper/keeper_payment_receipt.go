@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPaymentReceiptPrefix returns the key prefix of receipts of the request.
+// Receipts are ordered by height, receipts of the same transaction are ordered by the index within the transaction.
+func GetPaymentReceiptPrefix(requestId string) []byte {
+	return []byte(requestId + "/")
+}
+
+// GetPaymentReceiptCountKey returns the key of the receipt counter of the payer. Request ids are UUIDs, so they can't contain slashes.
+func GetPaymentReceiptCountKey(requestId string, payer string) []byte {
+	return []byte(requestId + "/" + payer)
+}
+
+func GetPaymentReceiptKey(requestId string, height int64, txHash string, index uint64) []byte {
+	key := GetPaymentReceiptPrefix(requestId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, []byte(txHash+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(index)...)
+}
+
+// AppendPaymentReceipt adds a receipt of a payment made for the request
+func (k Keeper) AppendPaymentReceipt(ctx sdk.Context, receipt types.PaymentReceipt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptKey))
+
+	// A transaction can contain several sends with the same memo
+	index := uint64(0)
+	for store.Has(GetPaymentReceiptKey(receipt.RequestId, receipt.Height, receipt.TxHash, index)) {
+		index++
+	}
+
+	b := k.cdc.MustMarshal(&receipt)
+	store.Set(GetPaymentReceiptKey(receipt.RequestId, receipt.Height, receipt.TxHash, index), b)
+
+	countStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptCountKey))
+	countKey := GetPaymentReceiptCountKey(receipt.RequestId, receipt.Payer)
+	countStore.Set(countKey, sdk.Uint64ToBigEndian(k.GetPaymentReceiptCount(ctx, receipt.RequestId, receipt.Payer)+1))
+}
+
+// GetPaymentReceiptCount returns the number of receipts of the payer for the request
+func (k Keeper) GetPaymentReceiptCount(ctx sdk.Context, requestId string, payer string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptCountKey))
+
+	bz := store.Get(GetPaymentReceiptCountKey(requestId, payer))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetPaymentReceipts returns receipts of all payments made for the request
+func (k Keeper) GetPaymentReceipts(ctx sdk.Context, requestId string) []types.PaymentReceipt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptKey))
+	return k.getPaymentReceipts(store, GetPaymentReceiptPrefix(requestId))
+}
+
+// GetAllPaymentReceipts returns all payment receipts
+func (k Keeper) GetAllPaymentReceipts(ctx sdk.Context) []types.PaymentReceipt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptKey))
+	return k.getPaymentReceipts(store, []byte{})
+}
+
+func (k Keeper) getPaymentReceipts(store prefix.Store, keyPrefix []byte) (list []types.PaymentReceipt) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PaymentReceipt
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetReservedId reserves or blocks the DID
+func (k Keeper) SetReservedId(ctx sdk.Context, reservedId types.ReservedId) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservedIdKey))
+	b := k.cdc.MustMarshal(&reservedId)
+	store.Set(GetDidIDBytes(reservedId.Did), b)
+}
+
+// GetReservedId returns the reservation of the DID
+func (k Keeper) GetReservedId(ctx sdk.Context, did string) (types.ReservedId, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservedIdKey))
+
+	bz := store.Get(GetDidIDBytes(did))
+	if bz == nil {
+		return types.ReservedId{}, false
+	}
+
+	var value types.ReservedId
+	k.cdc.MustUnmarshal(bz, &value)
+
+	return value, true
+}
+
+// RemoveReservedId makes the DID available to anyone
+func (k Keeper) RemoveReservedId(ctx sdk.Context, did string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservedIdKey))
+	store.Delete(GetDidIDBytes(did))
+}
+
+// GetAllReservedIds returns all reserved and blocked DIDs
+func (k Keeper) GetAllReservedIds(ctx sdk.Context) (list []types.ReservedId) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ReservedIdKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ReservedId
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetIdStatus returns whether the DID can be created
+func (k Keeper) GetIdStatus(ctx sdk.Context, did string) (types.IdStatus, *types.ReservedId) {
+	if k.HasDid(&ctx, did) {
+		return types.IdStatus_ID_STATUS_IN_USE, nil
+	}
+
+	reservedId, found := k.GetReservedId(ctx, did)
+	if !found {
+		return types.IdStatus_ID_STATUS_AVAILABLE, nil
+	}
+
+	if reservedId.IsBlocked() {
+		return types.IdStatus_ID_STATUS_BLOCKED, &reservedId
+	}
+
+	return types.IdStatus_ID_STATUS_RESERVED, &reservedId
+}
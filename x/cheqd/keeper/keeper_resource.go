@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetResource set a specific resource in the store.
+// The header is stored separately, so collections can be listed without reading the data,
+// and the latest version of each name and resource type is indexed.
+func (k Keeper) SetResource(ctx sdk.Context, resource *types.Resource, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(resource, metadata)
+	if err != nil {
+		return err
+	}
+
+	header := resource.Header
+	key := GetResourceKeyBytes(header.CollectionId, header.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(key, b)
+
+	headerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceHeaderKey))
+	headerStore.Set(key, k.cdc.MustMarshal(header))
+
+	if header.NextVersionId == "" {
+		latestStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceLatestVersionKey))
+		latestStore.Set(GetResourceLatestVersionKeyBytes(*header), []byte(header.Id))
+	}
+
+	return nil
+}
+
+// GetResource returns a resource from its collection and id
+func (k Keeper) GetResource(ctx sdk.Context, collectionId string, id string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceKey))
+
+	bytes := store.Get(GetResourceKeyBytes(collectionId, id))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrResourceNotFound.Wrapf("collection: %s, id: %s", collectionId, id)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasResource checks if the resource exists in the store
+func (k Keeper) HasResource(ctx sdk.Context, collectionId string, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceKey))
+	return store.Has(GetResourceKeyBytes(collectionId, id))
+}
+
+// GetAllResources returns all resources
+func (k Keeper) GetAllResources(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetLatestResourceVersion returns the resource of the collection that has the same name and resource type
+// as the header and doesn't have a next version yet
+func (k Keeper) GetLatestResourceVersion(ctx sdk.Context, header types.ResourceHeader) (value types.StateValue, found bool, err error) {
+	latestStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceLatestVersionKey))
+
+	id := latestStore.Get(GetResourceLatestVersionKeyBytes(header))
+	if id == nil {
+		return types.StateValue{}, false, nil
+	}
+
+	value, err = k.GetResource(ctx, header.CollectionId, string(id))
+	if err != nil {
+		return types.StateValue{}, false, err
+	}
+
+	return value, true, nil
+}
+
+// GetResourceKeyBytes returns the key of a resource. DIDs can't contain slashes, so resources of a collection share the prefix.
+func GetResourceKeyBytes(collectionId string, id string) []byte {
+	return append(GetResourceCollectionPrefixBytes(collectionId), []byte(id)...)
+}
+
+func GetResourceCollectionPrefixBytes(collectionId string) []byte {
+	return []byte(collectionId + "/")
+}
+
+// GetResourceLatestVersionKeyBytes returns the key of the latest version index entry.
+// The name is length prefixed, so names and resource types can contain any symbols.
+func GetResourceLatestVersionKeyBytes(header types.ResourceHeader) []byte {
+	key := GetResourceCollectionPrefixBytes(header.CollectionId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(len(header.Name)))...)
+	key = append(key, []byte(header.Name)...)
+	return append(key, []byte(header.ResourceType)...)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetRevocRegDef set a specific revocation registry definition in the store
+func (k Keeper) SetRevocRegDef(ctx sdk.Context, revocRegDef *types.RevocRegDef, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(revocRegDef, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegDefKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(GetDidIDBytes(revocRegDef.Id), b)
+	return nil
+}
+
+// GetRevocRegDef returns a revocation registry definition from its id
+func (k Keeper) GetRevocRegDef(ctx sdk.Context, id string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegDefKey))
+
+	bytes := store.Get(GetDidIDBytes(id))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrRevocRegDefNotFound.Wrap(id)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasRevocRegDef checks if the revocation registry definition exists in the store
+func (k Keeper) HasRevocRegDef(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegDefKey))
+	return store.Has(GetDidIDBytes(id))
+}
+
+// GetAllRevocRegDefs returns all revocation registry definitions
+func (k Keeper) GetAllRevocRegDefs(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegDefKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetRevocRegEntry set the latest entry of a revocation registry in the store
+func (k Keeper) SetRevocRegEntry(ctx sdk.Context, entry *types.RevocRegEntry, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(entry, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(GetDidIDBytes(entry.RevocRegDefId), b)
+	return nil
+}
+
+// GetRevocRegEntry returns the latest entry of a revocation registry
+func (k Keeper) GetRevocRegEntry(ctx sdk.Context, revocRegDefId string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryKey))
+
+	bytes := store.Get(GetDidIDBytes(revocRegDefId))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrRevocRegEntryNotFound.Wrap(revocRegDefId)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasRevocRegEntry checks if the revocation registry has at least one entry
+func (k Keeper) HasRevocRegEntry(ctx sdk.Context, revocRegDefId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryKey))
+	return store.Has(GetDidIDBytes(revocRegDefId))
+}
+
+// GetAllRevocRegEntries returns the latest entries of all revocation registries
+func (k Keeper) GetAllRevocRegEntries(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AppendRevocRegEntryHistory adds an entry to the history of its revocation registry.
+// Entries are ordered by their timestamp and sequence number.
+func (k Keeper) AppendRevocRegEntryHistory(ctx sdk.Context, entry types.RevocRegEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryHistoryKey))
+	store.Set(GetRevocRegEntryHistoryKeyBytes(entry.RevocRegDefId, entry.Timestamp, entry.SeqNo), k.cdc.MustMarshal(&entry))
+}
+
+// GetRevocRegEntryAtTime returns the last entry of a revocation registry written at or before the timestamp
+func (k Keeper) GetRevocRegEntryAtTime(ctx sdk.Context, revocRegDefId string, timestamp int64) (types.RevocRegEntry, error) {
+	if timestamp < 0 {
+		return types.RevocRegEntry{}, types.ErrRevocRegEntryNotFound.Wrapf("%s at %d", revocRegDefId, timestamp)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryHistoryKey))
+
+	start := GetRevocRegEntryHistoryPrefixBytes(revocRegDefId)
+	end := append(GetRevocRegEntryHistoryPrefixBytes(revocRegDefId), sdk.Uint64ToBigEndian(uint64(timestamp)+1)...)
+	iterator := store.ReverseIterator(start, end)
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	if !iterator.Valid() {
+		return types.RevocRegEntry{}, types.ErrRevocRegEntryNotFound.Wrapf("%s at %d", revocRegDefId, timestamp)
+	}
+
+	var entry types.RevocRegEntry
+	k.cdc.MustUnmarshal(iterator.Value(), &entry)
+	return entry, nil
+}
+
+// GetAllRevocRegEntryHistory returns the entry history of all revocation registries
+func (k Keeper) GetAllRevocRegEntryHistory(ctx sdk.Context) (list []types.RevocRegEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RevocRegEntryHistoryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RevocRegEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRevocRegEntryHistoryKeyBytes returns the key of a history entry.
+// Timestamps are not negative, so big endian encoding keeps them in order.
+func GetRevocRegEntryHistoryKeyBytes(revocRegDefId string, timestamp int64, seqNo uint64) []byte {
+	key := GetRevocRegEntryHistoryPrefixBytes(revocRegDefId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(timestamp))...)
+	return append(key, sdk.Uint64ToBigEndian(seqNo)...)
+}
+
+func GetRevocRegEntryHistoryPrefixBytes(revocRegDefId string) []byte {
+	return []byte(revocRegDefId + "/")
+}
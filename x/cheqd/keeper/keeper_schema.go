@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetSchema set a specific schema in the store
+func (k Keeper) SetSchema(ctx sdk.Context, schema *types.Schema, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(schema, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(GetDidIDBytes(schema.Id), b)
+	return nil
+}
+
+// GetSchema returns a schema from its id
+func (k Keeper) GetSchema(ctx sdk.Context, id string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+
+	bytes := store.Get(GetDidIDBytes(id))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrSchemaNotFound.Wrap(id)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasSchema checks if the schema exists in the store
+func (k Keeper) HasSchema(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	return store.Has(GetDidIDBytes(id))
+}
+
+// GetAllSchemas returns all schemas
+func (k Keeper) GetAllSchemas(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
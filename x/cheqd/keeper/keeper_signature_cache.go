@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+)
+
+// DefaultSignatureCacheSize is the number of verified signatures remembered by the keeper
+const DefaultSignatureCacheSize = 10000
+
+type signatureCacheKey [sha256.Size]byte
+
+// SignatureCache remembers successfully verified identity signatures, so that signatures checked during CheckTx
+// are not verified again during DeliverTx. Only successful verifications are cached and the key covers
+// the verification method, the signed bytes and the signature, so using the cache never changes the result.
+type SignatureCache struct {
+	mtx     sync.Mutex
+	size    int
+	entries map[signatureCacheKey]struct{}
+	order   []signatureCacheKey
+}
+
+func NewSignatureCache(size int) *SignatureCache {
+	return &SignatureCache{
+		size:    size,
+		entries: make(map[signatureCacheKey]struct{}, size),
+	}
+}
+
+func (c *SignatureCache) Has(vm types.VerificationMethod, message []byte, signature types.SignInfo) bool {
+	key := newSignatureCacheKey(vm, message, signature)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	_, found := c.entries[key]
+	return found
+}
+
+// Add remembers a verified signature, evicting the oldest entry when the cache is full
+func (c *SignatureCache) Add(vm types.VerificationMethod, message []byte, signature types.SignInfo) {
+	key := newSignatureCacheKey(vm, message, signature)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if _, found := c.entries[key]; found {
+		return
+	}
+
+	if len(c.order) >= c.size {
+		delete(c.entries, c.order[0])
+		c.order = c.order[1:]
+	}
+
+	c.entries[key] = struct{}{}
+	c.order = append(c.order, key)
+}
+
+func (c *SignatureCache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return len(c.entries)
+}
+
+func newSignatureCacheKey(vm types.VerificationMethod, message []byte, signature types.SignInfo) signatureCacheKey {
+	hasher := sha256.New()
+
+	for _, part := range [][]byte{mustMarshal(&vm), message, mustMarshal(&signature)} {
+		length := make([]byte, 8)
+		binary.BigEndian.PutUint64(length, uint64(len(part)))
+		hasher.Write(length)
+		hasher.Write(part)
+	}
+
+	var key signatureCacheKey
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
+
+func mustMarshal(msg interface{ Marshal() ([]byte, error) }) []byte {
+	bz, err := msg.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// VerifySignInfo verifies the signature, skipping the check if the same signature has already been verified
+func (k Keeper) VerifySignInfo(vm types.VerificationMethod, message []byte, signature types.SignInfo) error {
+	if k.sigCache.Has(vm, message, signature) {
+		return nil
+	}
+
+	err := types.VerifySignInfo(vm, message, signature)
+	if err != nil {
+		return err
+	}
+
+	k.sigCache.Add(vm, message, signature)
+	return nil
+}
+
+func (k Keeper) SignatureCache() *SignatureCache {
+	return k.sigCache
+}
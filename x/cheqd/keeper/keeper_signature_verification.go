@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyMsgSignatures checks the identity signatures of a message without executing it.
+// Verification methods are resolved from the committed state and from the DID Docs carried by the message.
+// Every signature must be valid for at least one of the methods with its id. Signatures of methods that
+// can't be resolved yet and all state dependent checks are left to the msg server.
+// Gas is consumed from the gas meter of the context.
+func (k Keeper) VerifyMsgSignatures(ctx sdk.Context, msg sdk.Msg) error {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+
+	var (
+		id         string
+		payload    types.IdentityMsg
+		signatures []*types.SignInfo
+		dids       []types.Did
+	)
+
+	switch msg := msg.(type) {
+	case *types.MsgCreateDid:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+		dids = []types.Did{msg.Payload.ToDid()}
+	case *types.MsgUpdateDid:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+		dids = []types.Did{msg.Payload.ToDid()}
+	case *types.MsgPatchDid:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+		dids = k.getPatchedDid(ctx, msg.Payload)
+	case *types.MsgCreateDidBatch:
+		id, payload, signatures = msg.Payload.Dids[0].Id, msg.Payload, msg.Signatures
+		dids = msg.Payload.ToDids()
+	case *types.MsgCreateSchema:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgCreateCredDef:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgCreateRevocRegDef:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgUpdateRevocRegDef:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgCreateRevocRegEntry:
+		id, payload, signatures = msg.Payload.RevocRegDefId, msg.Payload, msg.Signatures
+	case *types.MsgUpdateRevocRegEntry:
+		id, payload, signatures = msg.Payload.RevocRegDefId, msg.Payload, msg.Signatures
+	case *types.MsgCreateStatusList:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgUpdateStatusList:
+		id, payload, signatures = msg.Payload.Id, msg.Payload, msg.Signatures
+	case *types.MsgCreateResource:
+		id, payload, signatures = msg.Payload.CollectionId, msg.Payload, msg.Signatures
+	case *types.MsgClaimPayment:
+		// Claims are signed in the namespace of the issuer
+		id, payload, signatures = msg.Payload.Issuer, msg.Payload, msg.Signatures
+	default:
+		return nil
+	}
+
+	_, namespace, _, err := utils.TrySplitDID(id)
+	if err != nil {
+		return types.ErrBasicValidation.Wrap(err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), payload, signatures)
+
+	for _, signature := range signatures {
+		err = k.verifySignatureWithAnyMethod(ctx, params, dids, signPayload, *signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) verifySignatureWithAnyMethod(ctx sdk.Context, params types.Params, dids []types.Did, signPayload types.SignPayload, signature types.SignInfo) error {
+	var vms []types.VerificationMethod
+
+	for _, did := range dids {
+		for _, vm := range did.VerificationMethod {
+			if vm.Id == signature.VerificationMethodId {
+				vms = append(vms, *vm)
+			}
+		}
+	}
+
+	vm, found, err := FindVerificationMethod(&k, &ctx, params, map[string]types.StateValue{}, signature.VerificationMethodId)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		vms = append(vms, vm)
+	}
+
+	for _, vm := range vms {
+		err = VerifySignatureWithMethod(&k, &ctx, params, vm, signPayload, signature)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+// getPatchedDid returns the new version of the DID if the patch can be applied to the committed one
+func (k Keeper) getPatchedDid(ctx sdk.Context, payload *types.MsgPatchDidPayload) []types.Did {
+	stateValue, err := k.GetDid(&ctx, payload.Id)
+	if err != nil {
+		return nil
+	}
+
+	existingDid, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil
+	}
+
+	updatedDid, err := payload.ApplyTo(*existingDid)
+	if err != nil {
+		return nil
+	}
+
+	return []types.Did{updatedDid}
+}
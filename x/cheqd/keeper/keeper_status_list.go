@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetStatusList set a specific status list in the store. Bits of the list are stored separately.
+func (k Keeper) SetStatusList(ctx sdk.Context, statusList *types.StatusList, metadata *types.Metadata) error {
+	stateValue, err := types.NewStateValue(statusList, metadata)
+	if err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListKey))
+	b := k.cdc.MustMarshal(&stateValue)
+	store.Set(GetDidIDBytes(statusList.Id), b)
+	return nil
+}
+
+// GetStatusList returns a status list from its id
+func (k Keeper) GetStatusList(ctx sdk.Context, id string) (types.StateValue, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListKey))
+
+	bytes := store.Get(GetDidIDBytes(id))
+	if bytes == nil {
+		return types.StateValue{}, types.ErrStatusListNotFound.Wrap(id)
+	}
+
+	var value types.StateValue
+	if err := k.cdc.Unmarshal(bytes, &value); err != nil {
+		return types.StateValue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, err.Error())
+	}
+
+	return value, nil
+}
+
+// HasStatusList checks if the status list exists in the store
+func (k Keeper) HasStatusList(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListKey))
+	return store.Has(GetDidIDBytes(id))
+}
+
+// GetAllStatusLists returns all status lists
+func (k Keeper) GetAllStatusLists(ctx sdk.Context) (list []types.StateValue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateValue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetStatusListChunk writes a chunk of the status list bits
+func (k Keeper) SetStatusListChunk(ctx sdk.Context, chunk types.StatusListChunk) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListChunkKey))
+	store.Set(GetStatusListChunkKeyBytes(chunk.StatusListId, chunk.Index), chunk.Bits)
+}
+
+// GetStatusListChunk returns a chunk of the status list bits. Chunks that were never written are all zeros.
+func (k Keeper) GetStatusListChunk(ctx sdk.Context, id string, index uint64) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListChunkKey))
+
+	bits := store.Get(GetStatusListChunkKeyBytes(id, index))
+	if bits == nil {
+		return make([]byte, types.StatusListChunkSize)
+	}
+
+	return bits
+}
+
+// UpdateStatusListBits sets and clears bits of the status list.
+// Each of the touched chunks is read and written once.
+// Set and clear must be disjoint, payload validation rejects indices that are in both.
+func (k Keeper) UpdateStatusListBits(ctx sdk.Context, id string, set []uint64, clear []uint64) {
+	chunks := map[uint64][]byte{}
+	var order []uint64
+
+	apply := func(indices []uint64, value bool) {
+		for _, index := range indices {
+			chunkIndex, bit := types.GetStatusListChunkPosition(index)
+
+			chunk, found := chunks[chunkIndex]
+			if !found {
+				// Copy, so that the value cached by the store isn't modified
+				chunk = append([]byte{}, k.GetStatusListChunk(ctx, id, chunkIndex)...)
+				chunks[chunkIndex] = chunk
+				order = append(order, chunkIndex)
+			}
+
+			types.SetBit(chunk, bit, value)
+		}
+	}
+
+	apply(set, true)
+	apply(clear, false)
+
+	for _, chunkIndex := range order {
+		k.SetStatusListChunk(ctx, types.StatusListChunk{StatusListId: id, Index: chunkIndex, Bits: chunks[chunkIndex]})
+	}
+}
+
+// GetStatusListBit returns the bit of the status list
+func (k Keeper) GetStatusListBit(ctx sdk.Context, id string, index uint64) bool {
+	chunkIndex, bit := types.GetStatusListChunkPosition(index)
+	return types.GetBit(k.GetStatusListChunk(ctx, id, chunkIndex), bit)
+}
+
+// GetStatusListBits returns the whole bitstring of the status list
+func (k Keeper) GetStatusListBits(ctx sdk.Context, statusList types.StatusList) []byte {
+	bits := make([]byte, 0, statusList.ChunkCount()*types.StatusListChunkSize)
+	for chunkIndex := uint64(0); chunkIndex < statusList.ChunkCount(); chunkIndex++ {
+		bits = append(bits, k.GetStatusListChunk(ctx, statusList.Id, chunkIndex)...)
+	}
+
+	return bits[:statusList.Size_/8]
+}
+
+// GetAllStatusListChunks returns all written chunks of all status lists
+func (k Keeper) GetAllStatusListChunks(ctx sdk.Context) (list []types.StatusListChunk) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StatusListChunkKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+
+		// The key is <id>/<big endian index>
+		list = append(list, types.StatusListChunk{
+			StatusListId: string(key[:len(key)-9]),
+			Index:        sdk.BigEndianToUint64(key[len(key)-8:]),
+			Bits:         iterator.Value(),
+		})
+	}
+
+	return
+}
+
+// GetStatusListChunkKeyBytes returns the key of a chunk. DIDs can't contain slashes, so chunks of a list share the prefix.
+func GetStatusListChunkKeyBytes(id string, index uint64) []byte {
+	return append([]byte(id+"/"), sdk.Uint64ToBigEndian(index)...)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTrustedIssuer adds the entry to the trusted issuer registry or replaces it
+func (k Keeper) SetTrustedIssuer(ctx sdk.Context, trustedIssuer types.TrustedIssuer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerKey))
+	b := k.cdc.MustMarshal(&trustedIssuer)
+	store.Set(GetTrustedIssuerKeyBytes(trustedIssuer.Issuer, trustedIssuer.CredentialType), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerByCredentialTypeKey))
+	indexStore.Set(GetTrustedIssuerByCredentialTypeKeyBytes(trustedIssuer.CredentialType, trustedIssuer.Issuer), []byte{})
+}
+
+// GetTrustedIssuer returns the entry of the trusted issuer registry
+func (k Keeper) GetTrustedIssuer(ctx sdk.Context, ref types.TrustedIssuerRef) (types.TrustedIssuer, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerKey))
+
+	bz := store.Get(GetTrustedIssuerKeyBytes(ref.Issuer, ref.CredentialType))
+	if bz == nil {
+		return types.TrustedIssuer{}, false
+	}
+
+	var value types.TrustedIssuer
+	k.cdc.MustUnmarshal(bz, &value)
+
+	return value, true
+}
+
+// RemoveTrustedIssuer removes the entry from the trusted issuer registry
+func (k Keeper) RemoveTrustedIssuer(ctx sdk.Context, ref types.TrustedIssuerRef) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerKey))
+	store.Delete(GetTrustedIssuerKeyBytes(ref.Issuer, ref.CredentialType))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerByCredentialTypeKey))
+	indexStore.Delete(GetTrustedIssuerByCredentialTypeKeyBytes(ref.CredentialType, ref.Issuer))
+}
+
+// GetTrustedIssuersByIssuer returns all the entries of the trusted issuer registry accrediting the issuer
+func (k Keeper) GetTrustedIssuersByIssuer(ctx sdk.Context, issuer string) []types.TrustedIssuer {
+	return k.getTrustedIssuers(ctx, GetTrustedIssuerByIssuerPrefixBytes(issuer))
+}
+
+// GetAllTrustedIssuers returns all the entries of the trusted issuer registry
+func (k Keeper) GetAllTrustedIssuers(ctx sdk.Context) []types.TrustedIssuer {
+	return k.getTrustedIssuers(ctx, []byte{})
+}
+
+func (k Keeper) getTrustedIssuers(ctx sdk.Context, keyPrefix []byte) (list []types.TrustedIssuer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerKey))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err.Error())
+		}
+	}(iterator)
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TrustedIssuer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ValidateTrustedIssuerDid checks that the issuer DID can be accredited, i.e. it exists and isn't deactivated
+func (k Keeper) ValidateTrustedIssuerDid(ctx sdk.Context, issuer string) error {
+	if !k.HasDid(&ctx, issuer) {
+		return types.ErrDidDocNotFound.Wrap(issuer)
+	}
+
+	stateValue, err := k.GetDid(&ctx, issuer)
+	if err != nil {
+		return err
+	}
+
+	if stateValue.Metadata.GetDeactivated() {
+		return types.ErrDidDocDeactivated.Wrap(issuer)
+	}
+
+	return nil
+}
+
+// GetTrustedIssuerKeyBytes returns the key of the registry entry.
+// DIDs can't contain slashes, so entries of an issuer share the prefix.
+func GetTrustedIssuerKeyBytes(issuer, credentialType string) []byte {
+	return append(GetTrustedIssuerByIssuerPrefixBytes(issuer), []byte(credentialType)...)
+}
+
+func GetTrustedIssuerByIssuerPrefixBytes(issuer string) []byte {
+	return []byte(issuer + "/")
+}
+
+// GetTrustedIssuerByCredentialTypeKeyBytes returns the key of the credential type index entry.
+// Credential types can't contain whitespaces, so entries of a credential type share the prefix.
+func GetTrustedIssuerByCredentialTypeKeyBytes(credentialType, issuer string) []byte {
+	return append(GetTrustedIssuerByCredentialTypePrefixBytes(credentialType), []byte(issuer)...)
+}
+
+func GetTrustedIssuerByCredentialTypePrefixBytes(credentialType string) []byte {
+	return []byte(credentialType + " ")
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"encoding/base64"
-
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,7 +17,9 @@ func NewMsgServer(keeper Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-func FindDid(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, did string) (res types.StateValue, found bool, err error) {
+func FindDid(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, did string) (res types.StateValue, found bool, err error) {
+	params.GasParams.ConsumeResolutionGas(ctx.GasMeter())
+
 	// Look in inMemory dict
 	value, found := inMemoryDIDs[did]
 	if found {
@@ -39,8 +39,8 @@ func FindDid(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateVal
 	return types.StateValue{}, false, nil
 }
 
-func MustFindDid(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, did string) (res types.StateValue, err error) {
-	res, found, err := FindDid(k, ctx, inMemoryDIDs, did)
+func MustFindDid(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, did string) (res types.StateValue, err error) {
+	res, found, err := FindDid(k, ctx, params, inMemoryDIDs, did)
 	if err != nil {
 		return types.StateValue{}, err
 	}
@@ -52,14 +52,19 @@ func MustFindDid(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.Stat
 	return res, nil
 }
 
-func FindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, didUrl string) (res types.VerificationMethod, found bool, err error) {
+func FindVerificationMethod(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, didUrl string) (res types.VerificationMethod, found bool, err error) {
 	did, _, _, _ := utils.MustSplitDIDUrl(didUrl)
 
-	stateValue, found, err := FindDid(k, ctx, inMemoryDIDs, did)
+	stateValue, found, err := FindDid(k, ctx, params, inMemoryDIDs, did)
 	if err != nil || !found {
 		return types.VerificationMethod{}, found, err
 	}
 
+	// Keys of deactivated DIDs can't be used anymore
+	if stateValue.Metadata.GetDeactivated() {
+		return types.VerificationMethod{}, false, types.ErrDidDocDeactivated.Wrap(did)
+	}
+
 	didDoc, err := stateValue.UnpackDataAsDid()
 	if err != nil {
 		return types.VerificationMethod{}, false, err
@@ -74,8 +79,8 @@ func FindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string
 	return types.VerificationMethod{}, false, nil
 }
 
-func MustFindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, didUrl string) (res types.VerificationMethod, err error) {
-	res, found, err := FindVerificationMethod(k, ctx, inMemoryDIDs, didUrl)
+func MustFindVerificationMethod(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, didUrl string) (res types.VerificationMethod, err error) {
+	res, found, err := FindVerificationMethod(k, ctx, params, inMemoryDIDs, didUrl)
 	if err != nil {
 		return types.VerificationMethod{}, err
 	}
@@ -87,21 +92,149 @@ func MustFindVerificationMethod(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[st
 	return res, nil
 }
 
-func VerifySignature(k *Keeper, ctx *sdk.Context, inMemoryDIDs map[string]types.StateValue, message []byte, signature types.SignInfo) error {
-	verificationMethod, err := MustFindVerificationMethod(k, ctx, inMemoryDIDs, signature.VerificationMethodId)
+func VerifySignature(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, signPayload types.SignPayload, signature types.SignInfo) error {
+	verificationMethod, err := MustFindVerificationMethod(k, ctx, params, inMemoryDIDs, signature.VerificationMethodId)
+	if err != nil {
+		return err
+	}
+
+	return VerifySignatureWithMethod(k, ctx, params, verificationMethod, signPayload, signature)
+}
+
+// VerifySignatureWithMethod checks the signature against an already resolved verification method
+func VerifySignatureWithMethod(k *Keeper, ctx *sdk.Context, params types.Params, verificationMethod types.VerificationMethod, signPayload types.SignPayload, signature types.SignInfo) error {
+	err := types.DefaultIdentitySigVerificationGasConsumer(ctx.GasMeter(), verificationMethod, *params.GasParams)
 	if err != nil {
 		return err
 	}
 
-	signatureBytes, err := base64.StdEncoding.DecodeString(signature.Signature)
+	message, err := GetSignBytes(k, ctx, params, signPayload, signature)
 	if err != nil {
 		return err
 	}
 
-	err = types.VerifySignature(verificationMethod, message, signatureBytes)
+	err = k.VerifySignInfo(verificationMethod, message, signature)
 	if err != nil {
 		return types.ErrInvalidSignature.Wrapf("method id: %s", signature.VerificationMethodId)
 	}
 
 	return nil
 }
+
+// VerifyControllerSignatures checks that each of the controllers exists, is active and has signed the message
+func VerifyControllerSignatures(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, controllers []string, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	for _, controller := range controllers {
+		stateValue, err := MustFindDid(k, ctx, params, inMemoryDIDs, controller)
+		if err != nil {
+			return err
+		}
+
+		if stateValue.Metadata.GetDeactivated() {
+			return types.ErrDidDocDeactivated.Wrap(controller)
+		}
+
+		signature, found := types.FindSignInfoBySigner(signatures, controller)
+		if !found {
+			return types.ErrSignatureNotFound.Wrapf("signer: %s", controller)
+		}
+
+		err = VerifySignature(k, ctx, params, inMemoryDIDs, signPayload, signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyAssertionSignature checks that the DID is active and has signed the message with one of its assertion methods.
+// It succeeds if at least one of the assertion method signatures is valid.
+func VerifyAssertionSignature(k *Keeper, ctx *sdk.Context, params types.Params, did string, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	stateValue, err := MustFindDid(k, ctx, params, map[string]types.StateValue{}, did)
+	if err != nil {
+		return err
+	}
+
+	if stateValue.Metadata.GetDeactivated() {
+		return types.ErrDidDocDeactivated.Wrap(did)
+	}
+
+	didDoc, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return err
+	}
+
+	// Any valid signature is enough, the other ones may be made by stale keys
+	var verificationErr error
+	for _, signature := range signatures {
+		if !utils.Contains(didDoc.AssertionMethod, signature.VerificationMethodId) {
+			continue
+		}
+
+		verificationErr = VerifySignature(k, ctx, params, map[string]types.StateValue{}, signPayload, *signature)
+		if verificationErr == nil {
+			return nil
+		}
+	}
+
+	if verificationErr != nil {
+		return verificationErr
+	}
+
+	return types.ErrSignatureNotFound.Wrapf("assertion method signature of %s", did)
+}
+
+// VerifyProofOfPossession checks that each of the passed verification methods has signed the message.
+// The check is performed only if it's enabled by the module params.
+func VerifyProofOfPossession(k *Keeper, ctx *sdk.Context, params types.Params, vms []types.VerificationMethod, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	if !params.RequireProofOfPossession {
+		return nil
+	}
+
+	for _, vm := range vms {
+		signature, found := types.FindSignInfoByVerificationMethodId(signatures, vm.Id)
+		if !found {
+			return types.ErrSignatureNotFound.Wrapf("proof of possession is required for verification method %s", vm.Id)
+		}
+
+		err := types.DefaultIdentitySigVerificationGasConsumer(ctx.GasMeter(), vm, *params.GasParams)
+		if err != nil {
+			return err
+		}
+
+		message, err := GetSignBytes(k, ctx, params, signPayload, signature)
+		if err != nil {
+			return err
+		}
+
+		err = k.VerifySignInfo(vm, message, signature)
+		if err != nil {
+			return types.ErrInvalidSignature.Wrapf("proof of possession, method id: %s", vm.Id)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes checks that the signature envelope is acceptable at the current height and returns bytes covered by the signature
+func GetSignBytes(k *Keeper, ctx *sdk.Context, params types.Params, signPayload types.SignPayload, signature types.SignInfo) ([]byte, error) {
+	height := uint64(ctx.BlockHeight())
+
+	if signature.SignDocVersion == types.SignDocVersionLegacy {
+		endHeight := params.LegacySignaturesEndHeight
+		if endHeight != 0 && height > endHeight {
+			return nil, types.ErrLegacySignature.Wrapf("method id: %s", signature.VerificationMethodId)
+		}
+	}
+
+	if signature.ExpiryHeight != 0 && height > signature.ExpiryHeight {
+		return nil, types.ErrSignatureExpired.Wrapf("method id: %s, expiry height: %d", signature.VerificationMethodId, signature.ExpiryHeight)
+	}
+
+	signBytes, err := signPayload.GetSignBytesForSignInfo(signature)
+	if err != nil {
+		return nil, types.ErrInvalidSignature.Wrapf("method id: %s, err: %s", signature.VerificationMethodId, err.Error())
+	}
+
+	return signBytes, nil
+}
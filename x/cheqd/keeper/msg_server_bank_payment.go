@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// paymentMsgServer wraps the bank Msg server and records receipts of sends made for ADR-001 payment requests.
+// Receipts are written in the same message context as the send, so they are reverted together with it.
+// The request id is read from the transaction memo by ante.PaymentMemoDecorator.
+type paymentMsgServer struct {
+	banktypes.MsgServer
+	keeper Keeper
+}
+
+// NewPaymentMsgServer returns the bank Msg server that indexes sends whose transaction memo is a payment request id
+func NewPaymentMsgServer(bankMsgServer banktypes.MsgServer, keeper Keeper) banktypes.MsgServer {
+	return &paymentMsgServer{
+		MsgServer: bankMsgServer,
+		keeper:    keeper,
+	}
+}
+
+func (s paymentMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	res, err := s.MsgServer.Send(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	requestId, found := types.GetPaymentRequestIdFromContext(ctx)
+	if found {
+		s.recordPayment(ctx, requestId, msg.FromAddress, msg.ToAddress, msg.Amount)
+	}
+
+	return res, nil
+}
+
+// MultiSend records a receipt for each output if there is a single input, which is the payer.
+// Multi-send with several inputs isn't recorded, because outputs can't be attributed to payers.
+func (s paymentMsgServer) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
+	res, err := s.MsgServer.MultiSend(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	requestId, found := types.GetPaymentRequestIdFromContext(ctx)
+	if found && len(msg.Inputs) == 1 {
+		for _, output := range msg.Outputs {
+			s.recordPayment(ctx, requestId, msg.Inputs[0].Address, output.Address, output.Coins)
+		}
+	}
+
+	return res, nil
+}
+
+// recordPayment writes the receipt and charges gas for it, unless the payer has reached the limit of receipts for the request
+func (s paymentMsgServer) recordPayment(ctx sdk.Context, requestId string, payer string, payee string, amount sdk.Coins) {
+	if s.keeper.GetPaymentReceiptCount(ctx, requestId, payer) >= types.PaymentReceiptsPerPayerMax {
+		return
+	}
+
+	receipt := types.NewPaymentReceipt(requestId, payer, payee, amount, ctx.BlockHeight(), utils.GetTxHash(ctx.TxBytes()))
+	s.keeper.GetParams(ctx).GasParams.ConsumePaymentReceiptGas(ctx.GasMeter(), receipt)
+	s.keeper.AppendPaymentReceipt(ctx, receipt)
+}
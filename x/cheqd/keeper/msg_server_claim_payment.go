@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ClaimPayment(goCtx context.Context, msg *types.MsgClaimPayment) (*types.MsgClaimPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	escrow, err := k.GetEscrow(ctx, msg.Payload.Issuer, msg.Payload.Holder, msg.Payload.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if escrow.Status != types.EscrowStatus_ESCROW_STATUS_LOCKED {
+		return nil, types.ErrEscrowNotLocked.Wrapf("request id: %s, status: %s", escrow.RequestId, escrow.Status)
+	}
+
+	if escrow.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrEscrowExpired.Wrap(escrow.RequestId)
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, escrow.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	// Check that the issuer has signed the claim with an assertion key
+	err = VerifyAssertionSignature(&k.Keeper, &ctx, params, escrow.Issuer, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Payload.Recipient)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrap(err.Error())
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, recipient, sdk.NewCoins(*escrow.Amount))
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	escrow.Status = types.EscrowStatus_ESCROW_STATUS_CLAIMED
+	escrow.Recipient = msg.Payload.Recipient
+	k.SetEscrow(ctx, escrow)
+
+	return &types.MsgClaimPaymentResponse{
+		RequestId: escrow.RequestId,
+	}, nil
+}
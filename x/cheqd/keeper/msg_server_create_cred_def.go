@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateCredDef(goCtx context.Context, msg *types.MsgCreateCredDef) (*types.MsgCreateCredDefResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Credential definitions are immutable, so an existing one can't be overwritten
+	if k.HasCredDef(ctx, msg.Payload.Id) {
+		return nil, types.ErrCredDefExists.Wrap(msg.Payload.Id)
+	}
+
+	// Ids are shared with DIDs and the other ledger objects
+	if err := k.ValidateIdIsUnused(ctx, msg.Payload.Id); err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the referenced schema exists
+	if !k.HasSchema(ctx, msg.Payload.SchemaId) {
+		return nil, types.ErrSchemaNotFound.Wrap(msg.Payload.SchemaId)
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	credDef := msg.Payload.ToCredDef()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &credDef, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that all the issuers have signed the credential definition
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, credDef.Controller, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.SetCredDef(ctx, &credDef, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateCredDefResponse{
+		Id: credDef.Id,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"crypto/ed25519"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
@@ -10,19 +11,33 @@ import (
 
 func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*types.MsgCreateDidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
 
 	// Validate DID doesn't exist
 	if k.HasDid(&ctx, msg.Payload.Id) {
 		return nil, types.ErrDidDocExists.Wrap(msg.Payload.Id)
 	}
 
+	// Ids are shared with DIDs and the other ledger objects
+	if err := k.ValidateIdIsUnused(ctx, msg.Payload.Id); err != nil {
+		return nil, err
+	}
+
 	// Validate namespaces
-	namespace := k.GetDidNamespace(ctx)
-	err := msg.Validate([]string{namespace})
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
 	if err != nil {
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
 	// Build metadata and stateValue
 	did := msg.Payload.ToDid()
 	metadata := types.NewMetadataFromContext(ctx)
@@ -34,40 +49,169 @@ func (k msgServer) CreateDid(goCtx context.Context, msg *types.MsgCreateDid) (*t
 	// Consider did that we are going to create during did resolutions
 	inMemoryDids := map[string]types.StateValue{did.Id: stateValue}
 
+	// Check controllers and signatures
+	err = k.VerifyDidCreation(&ctx, params, inMemoryDids, did, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	err = k.AppendDid(&ctx, &did, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	err = EmitDidCreatedEvent(ctx, did, metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateDidResponse{
+		Id: did.Id,
+	}, nil
+}
+
+// VerifyDidCreation checks that controllers of the new did exist and all the required signatures are present and valid
+func (k msgServer) VerifyDidCreation(ctx *sdk.Context, params types.Params, inMemoryDids map[string]types.StateValue, did types.Did, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	// Check module limits
+	err := k.ValidateDidParams(*ctx, params, did, signPayload)
+	if err != nil {
+		return err
+	}
+
+	// Check that the DID isn't reserved for someone else or blocked
+	err = k.VerifyReservedId(ctx, params, inMemoryDids, did, signPayload, signatures)
+	if err != nil {
+		return err
+	}
+
+	// Check that the unique id is derived from the initial authentication key if the namespace requires it
+	initKeySignature, err := k.VerifySelfCertifyingId(ctx, params, inMemoryDids, did, signatures)
+	if err != nil {
+		return err
+	}
+
 	// Check controllers' existence
 	controllers := did.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDid(&k.Keeper, &ctx, inMemoryDids, controller)
+		_, err := MustFindDid(&k.Keeper, ctx, params, inMemoryDids, controller)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	// Verify signatures
+	// Verify signatures.
+	// The initial authentication key signs for its DID, so that its signature is verified only once.
 	signers := GetSignerDIDsForDIDCreation(did)
 	for _, signer := range signers {
-		signature, found := types.FindSignInfoBySigner(msg.Signatures, signer)
+		signature, found := types.FindSignInfoBySigner(signatures, signer)
+
+		if initKeySignature != nil && GetSigner(*initKeySignature) == signer {
+			signature, found = *initKeySignature, true
+			initKeySignature = nil
+		}
 
 		if !found {
-			return nil, types.ErrSignatureNotFound.Wrapf("signer: %s", signer)
+			return types.ErrSignatureNotFound.Wrapf("signer: %s", signer)
 		}
 
-		err := VerifySignature(&k.Keeper, &ctx, inMemoryDids, msg.Payload.GetSignBytes(), signature)
+		err := VerifySignature(&k.Keeper, ctx, params, inMemoryDids, signPayload, signature)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	// Apply changes
-	err = k.AppendDid(&ctx, &did, &metadata)
+	// The initial authentication key may belong to a DID that isn't a signer
+	if initKeySignature != nil {
+		err = VerifySignature(&k.Keeper, ctx, params, inMemoryDids, signPayload, *initKeySignature)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Verify that the keys being published are owned by the signers
+	return VerifyProofOfPossession(&k.Keeper, ctx, params, GetVerificationMethodsForDIDCreationProof(did), signPayload, signatures)
+}
+
+// VerifyReservedId checks that the DID isn't blocked and, if it's reserved, that the allowed creator key has signed the message
+func (k msgServer) VerifyReservedId(ctx *sdk.Context, params types.Params, inMemoryDids map[string]types.StateValue, did types.Did, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	reservedId, found := k.GetReservedId(*ctx, did.Id)
+	if !found {
+		return nil
+	}
+
+	if reservedId.IsBlocked() {
+		return types.ErrDidBlocked.Wrap(did.Id)
+	}
+
+	for _, signature := range signatures {
+		vm, found, err := FindVerificationMethod(&k.Keeper, ctx, params, inMemoryDids, signature.VerificationMethodId)
+		if err != nil || !found || !reservedId.IsAllowedCreator(vm) {
+			continue
+		}
+
+		return VerifySignature(&k.Keeper, ctx, params, inMemoryDids, signPayload, *signature)
+	}
+
+	return types.ErrDidReserved.Wrapf("%s: signature of the allowed creator key not found", did.Id)
+}
+
+// VerifySelfCertifyingId checks that the unique id is derived from the first authentication key
+// and returns the signature of the key, so that nobody else can publish the DID.
+// The signature isn't verified here, it's verified with the other signatures of the DID creation.
+// Nil is returned if the namespace doesn't require self-certifying ids.
+func (k msgServer) VerifySelfCertifyingId(ctx *sdk.Context, params types.Params, inMemoryDids map[string]types.StateValue, did types.Did, signatures []*types.SignInfo) (*types.SignInfo, error) {
+	_, name, uniqueId, err := utils.TrySplitDID(did.Id)
 	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
-	// Build and return response
-	return &types.MsgCreateDidResponse{
-		Id: did.Id,
-	}, nil
+	namespace, found := k.GetNamespace(*ctx, name)
+	if !found || !namespace.SelfCertifyingIds {
+		return nil, nil
+	}
+
+	if len(did.Authentication) == 0 {
+		return nil, types.ErrInvalidSelfCertifyingId.Wrapf("%s: authentication key not found", did.Id)
+	}
+
+	vm, err := MustFindVerificationMethod(&k.Keeper, ctx, params, inMemoryDids, did.Authentication[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return nil, types.ErrInvalidPublicKey.Wrapf("verification method: %s, err: %s", vm.Id, err.Error())
+	}
+
+	ed25519PubKey, ok := pubKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, types.ErrInvalidSelfCertifyingId.Wrapf("%s: initial authentication key must be Ed25519", did.Id)
+	}
+
+	expectedId, err := utils.DeriveSelfCertifyingId(ed25519PubKey)
+	if err != nil {
+		return nil, types.ErrInvalidPublicKey.Wrapf("verification method: %s, err: %s", vm.Id, err.Error())
+	}
+
+	if uniqueId != expectedId {
+		return nil, types.ErrInvalidSelfCertifyingId.Wrapf("%s: expected unique id: %s", did.Id, expectedId)
+	}
+
+	signature, found := types.FindSignInfoByVerificationMethodId(signatures, vm.Id)
+	if !found {
+		return nil, types.ErrSignatureNotFound.Wrapf("verification method: %s", vm.Id)
+	}
+
+	return &signature, nil
+}
+
+// GetSigner returns the DID that has made the signature
+func GetSigner(signature types.SignInfo) string {
+	did, _, _, _ := utils.MustSplitDIDUrl(signature.VerificationMethodId)
+	return did
 }
 
 func GetSignerDIDsForDIDCreation(did types.Did) []string {
@@ -76,3 +220,16 @@ func GetSignerDIDsForDIDCreation(did types.Did) []string {
 
 	return utils.UniqueSorted(res)
 }
+
+// GetVerificationMethodsForDIDCreationProof returns all signing-capable verification methods of the new DID
+func GetVerificationMethodsForDIDCreationProof(did types.Did) []types.VerificationMethod {
+	var res []types.VerificationMethod
+
+	for _, vm := range did.VerificationMethod {
+		if did.IsSigningVerificationMethod(vm.Id) {
+			res = append(res, *vm)
+		}
+	}
+
+	return res
+}
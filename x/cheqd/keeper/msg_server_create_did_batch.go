@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateDidBatch(goCtx context.Context, msg *types.MsgCreateDidBatch) (*types.MsgCreateDidBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Validate DIDs don't exist
+	for _, payload := range msg.Payload.Dids {
+		if k.HasDid(&ctx, payload.Id) {
+			return nil, types.ErrDidDocExists.Wrap(payload.Id)
+		}
+
+		// Ids are shared with DIDs and the other ledger objects
+		if err := k.ValidateIdIsUnused(ctx, payload.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate namespaces. All the signatures are bound to the namespace of the first DID.
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Dids[0].Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payload := range msg.Payload.Dids[1:] {
+		_, err := k.ValidateDidNamespaceIsActive(ctx, payload.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Build metadata and stateValues
+	dids := msg.Payload.ToDids()
+	metadata := types.NewMetadataFromContext(ctx)
+
+	// Consider all the dids that we are going to create during did resolutions,
+	// so that they can control each other
+	inMemoryDids := map[string]types.StateValue{}
+	for i := range dids {
+		stateValue, err := types.NewStateValue(&dids[i], &metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		inMemoryDids[dids[i].Id] = stateValue
+	}
+
+	// Check controllers and signatures. All the signatures are made over the whole batch.
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+	for _, did := range dids {
+		err := k.VerifyDidCreation(&ctx, params, inMemoryDids, did, signPayload, msg.Signatures)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply changes. Nothing is written if any of the checks above fail.
+	for i := range dids {
+		didMetadata := metadata
+		err = k.AppendDid(&ctx, &dids[i], &didMetadata)
+		if err != nil {
+			return nil, types.ErrInternal.Wrapf(err.Error())
+		}
+
+		err = EmitDidCreatedEvent(ctx, dids[i], didMetadata)
+		if err != nil {
+			return nil, types.ErrInternal.Wrapf(err.Error())
+		}
+	}
+
+	// Build and return response
+	return &types.MsgCreateDidBatchResponse{
+		Ids: msg.Payload.GetDidIds(),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateResource(goCtx context.Context, msg *types.MsgCreateResource) (*types.MsgCreateResourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Resources are immutable, a new version must have a new id
+	if k.HasResource(ctx, msg.Payload.CollectionId, msg.Payload.Id) {
+		return nil, types.ErrResourceExists.Wrapf("collection: %s, id: %s", msg.Payload.CollectionId, msg.Payload.Id)
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resources can be linked only to active DIDs
+	didStateValue, err := MustFindDid(&k.Keeper, &ctx, params, map[string]types.StateValue{}, msg.Payload.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if didStateValue.Metadata.GetDeactivated() {
+		return nil, types.ErrDidDocDeactivated.Wrap(msg.Payload.CollectionId)
+	}
+
+	did, err := didStateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	resource := msg.Payload.ToResource()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &resource, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the DID controllers have signed the resource
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, did.GetControllersOrSubject(), signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Link the previous version
+	previousStateValue, found, err := k.GetLatestResourceVersion(ctx, *resource.Header)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	if found {
+		previous, err := previousStateValue.UnpackDataAsResource()
+		if err != nil {
+			return nil, types.ErrInternal.Wrapf(err.Error())
+		}
+
+		resource.Header.PreviousVersionId = previous.Header.Id
+		previous.Header.NextVersionId = resource.Header.Id
+
+		previousMetadata := *previousStateValue.Metadata
+		previousMetadata.Update(ctx)
+
+		err = k.SetResource(ctx, previous, &previousMetadata)
+		if err != nil {
+			return nil, types.ErrInternal.Wrapf(err.Error())
+		}
+	}
+
+	// Apply changes
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.SetResource(ctx, &resource, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateResourceResponse{
+		Resource: resource.Header,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateRevocRegDef(goCtx context.Context, msg *types.MsgCreateRevocRegDef) (*types.MsgCreateRevocRegDefResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.HasRevocRegDef(ctx, msg.Payload.Id) {
+		return nil, types.ErrRevocRegDefExists.Wrap(msg.Payload.Id)
+	}
+
+	// Ids are shared with DIDs and the other ledger objects
+	if err := k.ValidateIdIsUnused(ctx, msg.Payload.Id); err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the referenced credential definition exists
+	credDefStateValue, err := k.GetCredDef(ctx, msg.Payload.CredDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	credDef, err := credDefStateValue.UnpackDataAsCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the issuers of the credential definition can control its revocation registries
+	for _, controller := range msg.Payload.Controller {
+		if !utils.Contains(credDef.Controller, controller) {
+			return nil, types.ErrBadRequest.Wrapf("%s is not a controller of the credential definition %s", controller, credDef.Id)
+		}
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	revocRegDef := msg.Payload.ToRevocRegDef()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &revocRegDef, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that all the controllers have signed the definition
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, revocRegDef.Controller, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.SetRevocRegDef(ctx, &revocRegDef, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateRevocRegDefResponse{
+		Id: revocRegDef.Id,
+	}, nil
+}
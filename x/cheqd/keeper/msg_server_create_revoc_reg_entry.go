@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateRevocRegEntry(goCtx context.Context, msg *types.MsgCreateRevocRegEntry) (*types.MsgCreateRevocRegEntryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// The first entry can be written only once, the following ones are updates
+	if k.HasRevocRegEntry(ctx, msg.Payload.RevocRegDefId) {
+		return nil, types.ErrRevocRegEntryExists.Wrap(msg.Payload.RevocRegDefId)
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.RevocRegDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	entry := msg.Payload.ToRevocRegEntry()
+	entry.SeqNo = 1
+
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.VerifyAndSetRevocRegEntry(ctx, params, entry, &metadata, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgCreateRevocRegEntryResponse{
+		RevocRegDefId: entry.RevocRegDefId,
+	}, nil
+}
+
+// VerifyAndSetRevocRegEntry checks that the entry is signed by the controllers of its revocation registry
+// and writes it to the store as the latest entry and to the history
+func (k msgServer) VerifyAndSetRevocRegEntry(ctx sdk.Context, params types.Params, entry types.RevocRegEntry, metadata *types.Metadata, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	revocRegDefStateValue, err := k.GetRevocRegDef(ctx, entry.RevocRegDefId)
+	if err != nil {
+		return err
+	}
+
+	revocRegDef, err := revocRegDefStateValue.UnpackDataAsRevocRegDef()
+	if err != nil {
+		return err
+	}
+
+	entry.Timestamp = ctx.BlockTime().Unix()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &entry, signPayload)
+	if err != nil {
+		return err
+	}
+
+	// Check that all the controllers of the revocation registry have signed the entry
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, revocRegDef.Controller, signPayload, signatures)
+	if err != nil {
+		return err
+	}
+
+	// Apply changes
+	err = k.SetRevocRegEntry(ctx, &entry, metadata)
+	if err != nil {
+		return types.ErrInternal.Wrapf(err.Error())
+	}
+
+	k.AppendRevocRegEntryHistory(ctx, entry)
+	return nil
+}
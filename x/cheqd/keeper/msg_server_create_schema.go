@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateSchema(goCtx context.Context, msg *types.MsgCreateSchema) (*types.MsgCreateSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Schemas are immutable, so an existing schema can't be overwritten
+	if k.HasSchema(ctx, msg.Payload.Id) {
+		return nil, types.ErrSchemaExists.Wrap(msg.Payload.Id)
+	}
+
+	// Ids are shared with DIDs and the other ledger objects
+	if err := k.ValidateIdIsUnused(ctx, msg.Payload.Id); err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	schema := msg.Payload.ToSchema()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &schema, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that all the controllers have signed the schema
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, schema.Controller, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.SetSchema(ctx, &schema, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateSchemaResponse{
+		Id: schema.Id,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) CreateStatusList(goCtx context.Context, msg *types.MsgCreateStatusList) (*types.MsgCreateStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.HasStatusList(ctx, msg.Payload.Id) {
+		return nil, types.ErrStatusListExists.Wrap(msg.Payload.Id)
+	}
+
+	// Ids are shared with DIDs and the other ledger objects
+	if err := k.ValidateIdIsUnused(ctx, msg.Payload.Id); err != nil {
+		return nil, err
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	statusList := msg.Payload.ToStatusList()
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &statusList, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the owner has signed the list with an assertion key
+	err = VerifyAssertionSignature(&k.Keeper, &ctx, params, statusList.Owner, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes. Bits aren't written, all of them are cleared initially.
+	metadata := types.NewMetadataFromContext(ctx)
+	err = k.SetStatusList(ctx, &statusList, &metadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgCreateStatusListResponse{
+		Id: statusList.Id,
+	}, nil
+}
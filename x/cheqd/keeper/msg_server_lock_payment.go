@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) LockPayment(goCtx context.Context, msg *types.MsgLockPayment) (*types.MsgLockPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if k.HasEscrow(ctx, msg.Issuer, msg.Holder, msg.RequestId) {
+		return nil, types.ErrEscrowExists.Wrapf("issuer: %s, holder: %s, request id: %s", msg.Issuer, msg.Holder, msg.RequestId)
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// The issuer must be able to claim the payment
+	issuer, err := MustFindDid(&k.Keeper, &ctx, params, map[string]types.StateValue{}, msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if issuer.Metadata.GetDeactivated() {
+		return nil, types.ErrDidDocDeactivated.Wrap(msg.Issuer)
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrap(err.Error())
+	}
+
+	expiresAt, err := types.GetEscrowExpiresAt(ctx.BlockTime(), msg.Timeout)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrap(err.Error())
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.EscrowAccountName, sdk.NewCoins(*msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetEscrow(ctx, types.NewEscrow(msg.RequestId, msg.Issuer, msg.Holder, *msg.Amount, expiresAt))
+
+	return &types.MsgLockPaymentResponse{
+		RequestId: msg.RequestId,
+		ExpiresAt: expiresAt,
+	}, nil
+}
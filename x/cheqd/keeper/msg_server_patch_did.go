@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) PatchDid(goCtx context.Context, msg *types.MsgPatchDid) (*types.MsgPatchDidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Validate DID does exist
+	if !k.HasDid(&ctx, msg.Payload.Id) {
+		return nil, types.ErrDidDocNotFound.Wrap(msg.Payload.Id)
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing state value and did
+	existingStateValue, err := k.GetDid(&ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	existingDid, err := existingStateValue.UnpackDataAsDid()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check version id, it prevents replays of signed patches
+	if msg.Payload.VersionId != existingStateValue.Metadata.VersionId {
+		return nil, types.ErrUnexpectedDidVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
+	}
+
+	// Build the new version of the DID and validate it the same way as full updates
+	updatedDid, err := msg.Payload.ApplyTo(*existingDid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updatedDid.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	// Verify signatures and apply changes
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+	err = k.VerifyAndSetUpdatedDid(&ctx, params, existingStateValue, *existingDid, updatedDid, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgPatchDidResponse{
+		Id: updatedDid.Id,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) ReclaimPayment(goCtx context.Context, msg *types.MsgReclaimPayment) (*types.MsgReclaimPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Escrows are keyed by the holder, so only the holder can find and reclaim its payment
+	escrow, err := k.GetEscrow(ctx, msg.Issuer, msg.Holder, msg.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if escrow.Status != types.EscrowStatus_ESCROW_STATUS_LOCKED {
+		return nil, types.ErrEscrowNotLocked.Wrapf("request id: %s, status: %s", escrow.RequestId, escrow.Status)
+	}
+
+	if !escrow.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrEscrowNotExpired.Wrapf("request id: %s, expires at: %d", escrow.RequestId, escrow.ExpiresAt)
+	}
+
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, types.ErrBadRequest.Wrap(err.Error())
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, holder, sdk.NewCoins(*escrow.Amount))
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	escrow.Status = types.EscrowStatus_ESCROW_STATUS_RECLAIMED
+	k.SetEscrow(ctx, escrow)
+
+	return &types.MsgReclaimPaymentResponse{
+		RequestId: escrow.RequestId,
+	}, nil
+}
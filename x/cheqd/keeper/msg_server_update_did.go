@@ -12,6 +12,7 @@ const UpdatedPostfix string = "-updated"
 
 func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*types.MsgUpdateDidResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
 
 	// Validate DID does exist
 	if !k.HasDid(&ctx, msg.Payload.Id) {
@@ -19,12 +20,17 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 	}
 
 	// Validate namespaces
-	namespace := k.GetDidNamespace(ctx)
-	err := msg.Validate([]string{namespace})
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
 	if err != nil {
 		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
 	}
 
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve existing state value and did
 	existingStateValue, err := k.GetDid(&ctx, msg.Payload.Id)
 	if err != nil {
@@ -41,20 +47,52 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 		return nil, types.ErrUnexpectedDidVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
 	}
 
-	// Get sign bytes before modifying payload
-	signBytes := msg.Payload.GetSignBytes()
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
 
-	// Construct the new version of the DID and temporary rename it and its self references
-	// in order to consider old and new versions different DIDs during signatures validation
+	// Verify signatures and apply changes
 	updatedDid := msg.Payload.ToDid()
+	err = k.VerifyAndSetUpdatedDid(&ctx, params, existingStateValue, *existingDid, updatedDid, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgUpdateDidResponse{
+		Id: updatedDid.Id,
+	}, nil
+}
+
+// VerifyAndSetUpdatedDid checks that updatedDid is properly signed to replace existingDid and writes it to the store
+func (k msgServer) VerifyAndSetUpdatedDid(ctx *sdk.Context, params types.Params, existingStateValue types.StateValue, existingDid types.Did, updatedDid types.Did, signPayload types.SignPayload, signatures []*types.SignInfo) error {
+	if existingStateValue.Metadata.GetDeactivated() {
+		return types.ErrDidDocDeactivated.Wrap(existingDid.Id)
+	}
+
+	// Check module limits
+	err := k.ValidateDidParams(*ctx, params, updatedDid, signPayload)
+	if err != nil {
+		return err
+	}
+
+	// Verify that the keys being added are owned by the signers.
+	// Done before renaming, so that verification method ids match the signatures.
+	err = VerifyProofOfPossession(&k.Keeper, ctx, params, GetVerificationMethodsForDIDUpdateProof(existingDid, updatedDid), signPayload, signatures)
+	if err != nil {
+		return err
+	}
+
+	// Temporary rename the new version of the DID and its self references
+	// in order to consider old and new versions different DIDs during signatures validation
 	updatedDid.ReplaceIds(updatedDid.Id, updatedDid.Id+UpdatedPostfix)
 
+	// Every update rotates the version id, so updates signed for the previous version can't be replayed
 	updatedMetadata := *existingStateValue.Metadata
-	updatedMetadata.Update(ctx)
+	updatedMetadata.UpdateVersion(*ctx)
 
 	updatedStateValue, err := types.NewStateValue(&updatedDid, &updatedMetadata)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Consider the new version of the DID a separate DID
@@ -63,27 +101,27 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 	// Check controllers existence
 	controllers := updatedDid.AllControllerDids()
 	for _, controller := range controllers {
-		_, err := MustFindDid(&k.Keeper, &ctx, inMemoryDids, controller)
+		_, err := MustFindDid(&k.Keeper, ctx, params, inMemoryDids, controller)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Verify signatures
 	// Duplicate signatures that reference the old version, make them reference a new (in memory) version
-	signers := GetSignerDIDsForDIDUpdate(*existingDid, updatedDid)
-	extendedSignatures := DuplicateSignatures(msg.Signatures, existingDid.Id, updatedDid.Id)
+	signers := GetSignerDIDsForDIDUpdate(existingDid, updatedDid)
+	extendedSignatures := DuplicateSignatures(signatures, existingDid.Id, updatedDid.Id)
 	for _, signer := range signers {
 		signaturesBySigner := types.FindSignInfosBySigner(extendedSignatures, signer)
 		signerForErrorMessage := GetSignerIdForErrorMessage(signer, existingDid.Id, updatedDid.Id)
 
 		if len(signaturesBySigner) == 0 {
-			return nil, types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage)
+			return types.ErrSignatureNotFound.Wrapf("there should be at least one signature by %s", signerForErrorMessage)
 		}
 
 		found := false
 		for _, signature := range signaturesBySigner {
-			err := VerifySignature(&k.Keeper, &ctx, inMemoryDids, signBytes, signature)
+			err := VerifyUpdateSignature(&k.Keeper, ctx, params, inMemoryDids, signPayload, signature, existingDid.Id, updatedDid.Id)
 			if err == nil {
 				found = true
 				break
@@ -91,21 +129,23 @@ func (k msgServer) UpdateDid(goCtx context.Context, msg *types.MsgUpdateDid) (*t
 		}
 
 		if !found {
-			return nil, types.ErrSignatureNotFound.Wrapf("there should be at least one valid signature by %s", signerForErrorMessage)
+			return types.ErrSignatureNotFound.Wrapf("there should be at least one valid signature by %s", signerForErrorMessage)
 		}
 	}
 
 	// Apply changes: return original id and modify state
 	updatedDid.ReplaceIds(updatedDid.Id, existingDid.Id)
-	err = k.SetDid(&ctx, &updatedDid, &updatedMetadata)
+	err = k.SetDid(ctx, &updatedDid, &updatedMetadata)
 	if err != nil {
-		return nil, types.ErrInternal.Wrapf(err.Error())
+		return types.ErrInternal.Wrapf(err.Error())
 	}
 
-	// Build and return response
-	return &types.MsgUpdateDidResponse{
-		Id: updatedDid.Id,
-	}, nil
+	err = EmitDidUpdatedEvent(*ctx, existingDid, *existingStateValue.Metadata, updatedDid, updatedMetadata)
+	if err != nil {
+		return types.ErrInternal.Wrapf(err.Error())
+	}
+
+	return nil
 }
 
 func GetSignerIdForErrorMessage(signerId string, existingVersionId string, updatedVersionId string) interface{} {
@@ -120,6 +160,24 @@ func GetSignerIdForErrorMessage(signerId string, existingVersionId string, updat
 	return signerId
 }
 
+// VerifyUpdateSignature verifies a signature of the existing or the temporary renamed version of a DID.
+// Keys of the renamed version are checked under the ids they have once the update is applied,
+// because signers reference them by those ids, e.g. in a JWS kid.
+func VerifyUpdateSignature(k *Keeper, ctx *sdk.Context, params types.Params, inMemoryDIDs map[string]types.StateValue, signPayload types.SignPayload, signature types.SignInfo, existingId string, renamedId string) error {
+	verificationMethod, err := MustFindVerificationMethod(k, ctx, params, inMemoryDIDs, signature.VerificationMethodId)
+	if err != nil {
+		return err
+	}
+
+	did, path, query, fragment := utils.MustSplitDIDUrl(signature.VerificationMethodId)
+	if did == renamedId {
+		signature.VerificationMethodId = utils.JoinDIDUrl(existingId, path, query, fragment)
+		verificationMethod.Id = signature.VerificationMethodId
+	}
+
+	return VerifySignatureWithMethod(k, ctx, params, verificationMethod, signPayload, signature)
+}
+
 func DuplicateSignatures(signatures []*types.SignInfo, didToDuplicate string, newDid string) []*types.SignInfo {
 	var result []*types.SignInfo
 
@@ -128,10 +186,8 @@ func DuplicateSignatures(signatures []*types.SignInfo, didToDuplicate string, ne
 
 		did, path, query, fragment := utils.MustSplitDIDUrl(signature.VerificationMethodId)
 		if did == didToDuplicate {
-			duplicate := types.SignInfo{
-				VerificationMethodId: utils.JoinDIDUrl(newDid, path, query, fragment),
-				Signature:            signature.Signature,
-			}
+			duplicate := *signature
+			duplicate.VerificationMethodId = utils.JoinDIDUrl(newDid, path, query, fragment)
 
 			result = append(result, &duplicate)
 		}
@@ -181,3 +237,35 @@ func GetSignerDIDsForDIDUpdate(existingDid types.Did, updatedDid types.Did) []st
 
 	return utils.UniqueSorted(signers)
 }
+
+// GetVerificationMethodsForDIDUpdateProof returns signing-capable verification methods that are added
+// or get new key material during the update
+func GetVerificationMethodsForDIDUpdateProof(existingDid types.Did, updatedDid types.Did) []types.VerificationMethod {
+	var res []types.VerificationMethod
+
+	existingVMMap := types.VerificationMethodListToMapByFragment(existingDid.VerificationMethod)
+
+	for _, updatedVM := range updatedDid.VerificationMethod {
+		if !updatedDid.IsSigningVerificationMethod(updatedVM.Id) {
+			continue
+		}
+
+		_, _, _, fragment := utils.MustSplitDIDUrl(updatedVM.Id)
+		existingVM, found := existingVMMap[fragment]
+
+		// VM added
+		if !found {
+			res = append(res, *updatedVM)
+			continue
+		}
+
+		// Key material changed. Changing the controller only doesn't require a proof.
+		existingKey, updatedKey := existingVM, *updatedVM
+		existingKey.Controller, updatedKey.Controller = "", ""
+		if !types.CompareVerificationMethodsWithoutIds(existingKey, updatedKey) {
+			res = append(res, *updatedVM)
+		}
+	}
+
+	return res
+}
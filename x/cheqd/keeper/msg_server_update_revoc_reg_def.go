@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateRevocRegDef(goCtx context.Context, msg *types.MsgUpdateRevocRegDef) (*types.MsgUpdateRevocRegDefResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if !k.HasRevocRegDef(ctx, msg.Payload.Id) {
+		return nil, types.ErrRevocRegDefNotFound.Wrap(msg.Payload.Id)
+	}
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing state value and definition
+	existingStateValue, err := k.GetRevocRegDef(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	existingRevocRegDef, err := existingStateValue.UnpackDataAsRevocRegDef()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check version id
+	if msg.Payload.VersionId != existingStateValue.Metadata.VersionId {
+		return nil, types.ErrUnexpectedRevocRegVersion.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	// Only the value of a definition can be changed
+	updatedRevocRegDef := *existingRevocRegDef
+	updatedRevocRegDef.Value = msg.Payload.Value
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, &updatedRevocRegDef, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that all the controllers have signed the update
+	err = VerifyControllerSignatures(&k.Keeper, &ctx, params, map[string]types.StateValue{}, updatedRevocRegDef.Controller, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	updatedMetadata := *existingStateValue.Metadata
+	updatedMetadata.UpdateVersion(ctx)
+
+	err = k.SetRevocRegDef(ctx, &updatedRevocRegDef, &updatedMetadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgUpdateRevocRegDefResponse{
+		Id: updatedRevocRegDef.Id,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateRevocRegEntry(goCtx context.Context, msg *types.MsgUpdateRevocRegEntry) (*types.MsgUpdateRevocRegEntryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.RevocRegDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve the latest entry
+	latestStateValue, err := k.GetRevocRegEntry(ctx, msg.Payload.RevocRegDefId)
+	if err != nil {
+		return nil, err
+	}
+
+	latestEntry, err := latestStateValue.UnpackDataAsRevocRegEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	// The delta must be applied to the latest accumulator
+	if msg.Payload.PrevAccum != latestEntry.Accum {
+		return nil, types.ErrUnexpectedRevocRegVersion.Wrapf("got prev_accum: %s, must be: %s", msg.Payload.PrevAccum, latestEntry.Accum)
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	entry := msg.Payload.ToRevocRegEntry()
+	entry.SeqNo = latestEntry.SeqNo + 1
+
+	updatedMetadata := *latestStateValue.Metadata
+	updatedMetadata.UpdateVersion(ctx)
+
+	err = k.VerifyAndSetRevocRegEntry(ctx, params, entry, &updatedMetadata, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and return response
+	return &types.MsgUpdateRevocRegEntryResponse{
+		RevocRegDefId: entry.RevocRegDefId,
+		SeqNo:         entry.SeqNo,
+	}, nil
+}
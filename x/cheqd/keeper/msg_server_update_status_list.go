@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) UpdateStatusList(goCtx context.Context, msg *types.MsgUpdateStatusList) (*types.MsgUpdateStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	// Validate namespaces
+	allowedNamespaces := k.GetRegisteredNamespaceNames(ctx)
+	err := msg.Validate(allowedNamespaces)
+	if err != nil {
+		return nil, types.ErrNamespaceValidation.Wrap(err.Error())
+	}
+
+	namespace, err := k.ValidateDidNamespaceIsActive(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Retrieve existing state value and list
+	existingStateValue, err := k.GetStatusList(ctx, msg.Payload.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := existingStateValue.UnpackDataAsStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check version id
+	if msg.Payload.VersionId != existingStateValue.Metadata.VersionId {
+		return nil, types.ErrStatusListVersionMismatch.Wrapf("got: %s, must be: %s", msg.Payload.VersionId, existingStateValue.Metadata.VersionId)
+	}
+
+	for _, indices := range [][]uint64{msg.Payload.Set, msg.Payload.Clear} {
+		for _, index := range indices {
+			if index >= statusList.Size_ {
+				return nil, types.ErrStatusListIndexOutOfRange.Wrapf("index: %d, size: %d", index, statusList.Size_)
+			}
+		}
+	}
+
+	// Get sign payload before modifying payload
+	signPayload := types.NewSignPayload(ctx.ChainID(), namespace, sdk.MsgTypeURL(msg), msg.Payload, msg.Signatures)
+
+	// Check module limits
+	err = k.ValidateResourceParams(ctx, params, statusList, signPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the owner has signed the update with an assertion key
+	err = VerifyAssertionSignature(&k.Keeper, &ctx, params, statusList.Owner, signPayload, msg.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply changes
+	k.UpdateStatusListBits(ctx, statusList.Id, msg.Payload.Set, msg.Payload.Clear)
+
+	updatedMetadata := *existingStateValue.Metadata
+	updatedMetadata.UpdateVersion(ctx)
+
+	err = k.SetStatusList(ctx, statusList, &updatedMetadata)
+	if err != nil {
+		return nil, types.ErrInternal.Wrapf(err.Error())
+	}
+
+	// Build and return response
+	return &types.MsgUpdateStatusListResponse{
+		Id: statusList.Id,
+	}, nil
+}
@@ -20,6 +20,63 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		case types.QueryGetDid:
 			return getDid(ctx, path[1], k, legacyQuerierCdc)
 
+		case types.QueryGetDidDiff:
+			return getDidDiff(ctx, path[1], path[2], path[3], k, legacyQuerierCdc)
+
+		case types.QueryGetParams:
+			return getParams(ctx, k, legacyQuerierCdc)
+
+		case types.QueryGetFeeSchedule:
+			return getFeeSchedule(ctx, k, legacyQuerierCdc)
+
+		case types.QueryGetNamespaces:
+			return getNamespaces(ctx, k, legacyQuerierCdc)
+
+		case types.QueryGetTrustedIssuersByIssuer:
+			return getTrustedIssuersByIssuer(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetTrustedIssuersByCredentialType:
+			return getTrustedIssuersByCredentialType(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetIdStatus:
+			return getIdStatus(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetSchema:
+			return getSchema(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetCredDef:
+			return getCredDef(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetCredDefsByIssuer:
+			return getCredDefsByIssuer(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetRevocRegDef:
+			return getRevocRegDef(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetRevocRegEntry:
+			return getRevocRegEntry(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetRevocRegEntryAtTime:
+			return getRevocRegEntryAtTime(ctx, path[1], path[2], k, legacyQuerierCdc)
+
+		case types.QueryGetStatusList:
+			return getStatusList(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetStatusListIndex:
+			return getStatusListIndex(ctx, path[1], path[2], k, legacyQuerierCdc)
+
+		case types.QueryGetResource:
+			return getResource(ctx, path[1], path[2], k, legacyQuerierCdc)
+
+		case types.QueryGetCollectionResources:
+			return getCollectionResources(ctx, path[1], k, legacyQuerierCdc)
+
+		case types.QueryGetEscrow:
+			return getEscrow(ctx, path[1], path[2], path[3], k, legacyQuerierCdc)
+
+		case types.QueryGetPaymentReceipt:
+			return getPaymentReceipt(ctx, path[1], k, legacyQuerierCdc)
+
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
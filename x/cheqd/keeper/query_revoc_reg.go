@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func getRevocRegDef(ctx sdk.Context, id string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.RevocRegDef(sdk.WrapSDKContext(ctx), &types.QueryGetRevocRegDefRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getRevocRegEntry(ctx sdk.Context, revocRegDefId string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.RevocRegEntry(sdk.WrapSDKContext(ctx), &types.QueryGetRevocRegEntryRequest{RevocRegDefId: revocRegDefId})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getRevocRegEntryAtTime(ctx sdk.Context, revocRegDefId string, timestamp string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	parsedTimestamp, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.RevocRegEntryAtTime(sdk.WrapSDKContext(ctx), &types.QueryGetRevocRegEntryAtTimeRequest{RevocRegDefId: revocRegDefId, Timestamp: parsedTimestamp})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
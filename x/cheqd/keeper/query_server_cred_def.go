@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) CredDef(c context.Context, req *types.QueryGetCredDefRequest) (*types.QueryGetCredDefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetCredDef(ctx, utils.NormalizeDID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	credDef, err := stateValue.UnpackDataAsCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetCredDefResponse{CredDef: credDef, Metadata: stateValue.Metadata}, nil
+}
+
+func (k Keeper) CredDefsByIssuer(c context.Context, req *types.QueryCredDefsByIssuerRequest) (*types.QueryCredDefsByIssuerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredDefByIssuerKey))
+	issuerStore := prefix.NewStore(indexStore, GetCredDefByIssuerPrefixBytes(utils.NormalizeDID(req.Issuer)))
+
+	var credDefs []*types.CredDef
+	pageRes, err := query.Paginate(issuerStore, req.Pagination, func(key []byte, _ []byte) error {
+		stateValue, err := k.GetCredDef(ctx, string(key))
+		if err != nil {
+			return types.ErrInternal.Wrapf("credential definition index is inconsistent: %s", key)
+		}
+
+		credDef, err := stateValue.UnpackDataAsCredDef()
+		if err != nil {
+			return err
+		}
+
+		credDefs = append(credDefs, credDef)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCredDefsByIssuerResponse{CredDefs: credDefs, Pagination: pageRes}, nil
+}
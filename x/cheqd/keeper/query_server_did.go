@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,7 +17,7 @@ func (k Keeper) Did(c context.Context, req *types.QueryGetDidRequest) (*types.Qu
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	stateValue, err := k.GetDid(&ctx, req.Id)
+	stateValue, err := k.GetDid(&ctx, utils.NormalizeDID(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDiff(c context.Context, req *types.QueryDidDiffRequest) (*types.QueryDidDiffResponse, error) {
+	if req == nil || req.FromVersion == "" || req.ToVersion == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	id := utils.NormalizeDID(req.Id)
+
+	from, err := k.getDidVersionAsDid(&ctx, id, req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := k.getDidVersionAsDid(&ctx, id, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := types.NewDidDiff(*from, req.FromVersion, *to, req.ToVersion)
+	return &types.QueryDidDiffResponse{Diff: &diff}, nil
+}
+
+func (k Keeper) getDidVersionAsDid(ctx *sdk.Context, id string, versionId string) (*types.Did, error) {
+	stateValue, err := k.GetDidVersion(ctx, id, versionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return stateValue.UnpackDataAsDid()
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Escrow(c context.Context, req *types.QueryGetEscrowRequest) (*types.QueryGetEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	escrow, err := k.GetEscrow(ctx, utils.NormalizeDID(req.Issuer), req.Holder, req.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetEscrowResponse{Escrow: &escrow}, nil
+}
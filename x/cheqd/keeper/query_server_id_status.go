@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IdStatus(c context.Context, req *types.QueryIdStatusRequest) (*types.QueryIdStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	idStatus, reservedId := k.GetIdStatus(ctx, utils.NormalizeDID(req.Id))
+
+	return &types.QueryIdStatusResponse{Status: idStatus, ReservedId: reservedId}, nil
+}
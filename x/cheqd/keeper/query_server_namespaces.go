@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Namespaces(c context.Context, req *types.QueryNamespacesRequest) (*types.QueryNamespacesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var namespaces []*types.Namespace
+	for _, namespace := range k.GetAllNamespaces(ctx) {
+		namespace := namespace
+		namespaces = append(namespaces, &namespace)
+	}
+
+	return &types.QueryNamespacesResponse{Namespaces: namespaces}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PaymentReceipt(c context.Context, req *types.QueryPaymentReceiptRequest) (*types.QueryPaymentReceiptResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !utils.IsUUID(req.RequestId) {
+		return nil, status.Error(codes.InvalidArgument, "request id must be a UUID")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Any account can pay with the request id in the memo, so the number of receipts is only limited per payer
+	receiptStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PaymentReceiptKey))
+	requestStore := prefix.NewStore(receiptStore, GetPaymentReceiptPrefix(utils.NormalizeId(req.RequestId)))
+
+	var receipts []*types.PaymentReceipt
+	pageRes, err := query.Paginate(requestStore, req.Pagination, func(_ []byte, value []byte) error {
+		var receipt types.PaymentReceipt
+		if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+			return err
+		}
+
+		receipts = append(receipts, &receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPaymentReceiptResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
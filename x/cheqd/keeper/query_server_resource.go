@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResourcesPathPrefix is the DID URL path prefix of DID-linked resources
+const ResourcesPathPrefix = "/resources/"
+
+func (k Keeper) Resource(c context.Context, req *types.QueryGetResourceRequest) (*types.QueryGetResourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetResource(ctx, utils.NormalizeDID(req.CollectionId), utils.NormalizeId(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := stateValue.UnpackDataAsResource()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetResourceResponse{Resource: resource, Metadata: stateValue.Metadata}, nil
+}
+
+func (k Keeper) CollectionResources(c context.Context, req *types.QueryCollectionResourcesRequest) (*types.QueryCollectionResourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Only headers are read, the data of resources can be large
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ResourceHeaderKey))
+	collectionStore := prefix.NewStore(store, GetResourceCollectionPrefixBytes(utils.NormalizeDID(req.CollectionId)))
+
+	var resources []*types.ResourceHeader
+	pageRes, err := query.Paginate(collectionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var header types.ResourceHeader
+		if err := k.cdc.Unmarshal(value, &header); err != nil {
+			return err
+		}
+
+		resources = append(resources, &header)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollectionResourcesResponse{Resources: resources, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DereferenceResource(c context.Context, req *types.QueryDereferenceResourceRequest) (*types.QueryGetResourceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	did, path, query, fragment, err := utils.TrySplitDIDUrl(req.DidUrl)
+	if err != nil || query != "" || fragment != "" || !strings.HasPrefix(path, ResourcesPathPrefix) {
+		return nil, status.Errorf(codes.InvalidArgument, "DID URL must have the form <did>%s<uuid>: %s", ResourcesPathPrefix, req.DidUrl)
+	}
+
+	return k.Resource(c, &types.QueryGetResourceRequest{
+		CollectionId: did,
+		Id:           strings.TrimPrefix(path, ResourcesPathPrefix),
+	})
+}
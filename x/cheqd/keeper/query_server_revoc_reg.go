@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RevocRegDef(c context.Context, req *types.QueryGetRevocRegDefRequest) (*types.QueryGetRevocRegDefResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetRevocRegDef(ctx, utils.NormalizeDID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	revocRegDef, err := stateValue.UnpackDataAsRevocRegDef()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetRevocRegDefResponse{RevocRegDef: revocRegDef, Metadata: stateValue.Metadata}, nil
+}
+
+func (k Keeper) RevocRegEntry(c context.Context, req *types.QueryGetRevocRegEntryRequest) (*types.QueryGetRevocRegEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetRevocRegEntry(ctx, utils.NormalizeDID(req.RevocRegDefId))
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := stateValue.UnpackDataAsRevocRegEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetRevocRegEntryResponse{RevocRegEntry: entry, Metadata: stateValue.Metadata}, nil
+}
+
+func (k Keeper) RevocRegEntryAtTime(c context.Context, req *types.QueryGetRevocRegEntryAtTimeRequest) (*types.QueryGetRevocRegEntryAtTimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entry, err := k.GetRevocRegEntryAtTime(ctx, utils.NormalizeDID(req.RevocRegDefId), req.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetRevocRegEntryAtTimeResponse{RevocRegEntry: &entry}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Schema(c context.Context, req *types.QueryGetSchemaRequest) (*types.QueryGetSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetSchema(ctx, utils.NormalizeDID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := stateValue.UnpackDataAsSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGetSchemaResponse{Schema: schema, Metadata: stateValue.Metadata}, nil
+}
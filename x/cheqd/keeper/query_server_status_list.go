@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StatusList(c context.Context, req *types.QueryGetStatusListRequest) (*types.QueryGetStatusListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetStatusList(ctx, utils.NormalizeDID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := stateValue.UnpackDataAsStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	encodedList, err := types.EncodeStatusList(k.GetStatusListBits(ctx, *statusList))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetStatusListResponse{StatusList: statusList, EncodedList: encodedList, Metadata: stateValue.Metadata}, nil
+}
+
+func (k Keeper) StatusListIndex(c context.Context, req *types.QueryGetStatusListIndexRequest) (*types.QueryGetStatusListIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateValue, err := k.GetStatusList(ctx, utils.NormalizeDID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := stateValue.UnpackDataAsStatusList()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Index >= statusList.Size_ {
+		return nil, types.ErrStatusListIndexOutOfRange.Wrapf("index: %d, size: %d", req.Index, statusList.Size_)
+	}
+
+	return &types.QueryGetStatusListIndexResponse{Set: k.GetStatusListBit(ctx, statusList.Id, req.Index)}, nil
+}
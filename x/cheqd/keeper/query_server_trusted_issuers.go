@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TrustedIssuersByIssuer(c context.Context, req *types.QueryTrustedIssuersByIssuerRequest) (*types.QueryTrustedIssuersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerKey))
+	issuerStore := prefix.NewStore(store, GetTrustedIssuerByIssuerPrefixBytes(utils.NormalizeDID(req.Issuer)))
+
+	var trustedIssuers []*types.TrustedIssuer
+	pageRes, err := query.Paginate(issuerStore, req.Pagination, func(key []byte, value []byte) error {
+		var trustedIssuer types.TrustedIssuer
+		if err := k.cdc.Unmarshal(value, &trustedIssuer); err != nil {
+			return err
+		}
+
+		trustedIssuers = append(trustedIssuers, &trustedIssuer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrustedIssuersResponse{TrustedIssuers: trustedIssuers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) TrustedIssuersByCredentialType(c context.Context, req *types.QueryTrustedIssuersByCredentialTypeRequest) (*types.QueryTrustedIssuersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TrustedIssuerByCredentialTypeKey))
+	credentialTypeStore := prefix.NewStore(indexStore, GetTrustedIssuerByCredentialTypePrefixBytes(req.CredentialType))
+
+	var trustedIssuers []*types.TrustedIssuer
+	pageRes, err := query.Paginate(credentialTypeStore, req.Pagination, func(key []byte, _ []byte) error {
+		trustedIssuer, found := k.GetTrustedIssuer(ctx, types.NewTrustedIssuerRef(string(key), req.CredentialType))
+		if !found {
+			return types.ErrInternal.Wrapf("trusted issuer index is inconsistent: %s", key)
+		}
+
+		trustedIssuers = append(trustedIssuers, &trustedIssuer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTrustedIssuersResponse{TrustedIssuers: trustedIssuers, Pagination: pageRes}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func getStatusList(ctx sdk.Context, id string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.StatusList(sdk.WrapSDKContext(ctx), &types.QueryGetStatusListRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getStatusListIndex(ctx sdk.Context, id string, index string, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	parsedIndex, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	queryServer := NewQueryServer(keeper)
+
+	resp, err := queryServer.StatusListIndex(sdk.WrapSDKContext(ctx), &types.QueryGetStatusListIndexRequest{Id: id, Index: parsedIndex})
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,195 @@
+package cheqd
+
+import (
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// NewProposalHandler creates a governance handler to manage cheqd module proposals
+func NewProposalHandler(k keeper.Keeper, gk types.GovKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetNamespacesProposal:
+			return handleSetNamespacesProposal(ctx, k, c)
+
+		case *types.DeactivateDidProposal:
+			return handleDeactivateDidProposal(ctx, k, gk, c)
+
+		case *types.AddTrustedIssuersProposal:
+			return handleAddTrustedIssuersProposal(ctx, k, c)
+
+		case *types.RemoveTrustedIssuersProposal:
+			return handleRemoveTrustedIssuersProposal(ctx, k, c)
+
+		case *types.SetReservedIdsProposal:
+			return handleSetReservedIdsProposal(ctx, k, c)
+
+		case *types.ReleaseReservedIdsProposal:
+			return handleReleaseReservedIdsProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s proposal content type: %T", types.ModuleName, c)
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+func handleSetNamespacesProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetNamespacesProposal) error {
+	// Check all the transitions before applying any of them
+	for _, namespace := range p.Namespaces {
+		existing, found := k.GetNamespace(ctx, namespace.Name)
+		if !found {
+			continue
+		}
+
+		if err := existing.CanTransitionTo(namespace.Status); err != nil {
+			return types.ErrNamespaceValidation.Wrap(err.Error())
+		}
+	}
+
+	// Networks that still use the legacy namespace get it stored in the registry
+	for _, namespace := range k.GetAllNamespaces(ctx) {
+		k.SetNamespace(ctx, namespace)
+	}
+
+	for _, namespace := range p.Namespaces {
+		k.SetNamespace(ctx, *namespace)
+	}
+
+	return nil
+}
+
+func handleDeactivateDidProposal(ctx sdk.Context, k keeper.Keeper, gk types.GovKeeper, p *types.DeactivateDidProposal) error {
+	if !k.HasDid(&ctx, p.Did) {
+		return types.ErrDidDocNotFound.Wrap(p.Did)
+	}
+
+	stateValue, err := k.GetDid(&ctx, p.Did)
+	if err != nil {
+		return err
+	}
+
+	did, err := stateValue.UnpackDataAsDid()
+	if err != nil {
+		return err
+	}
+
+	metadata := *stateValue.Metadata
+	if metadata.Deactivated {
+		return types.ErrDidDocDeactivated.Wrap(p.Did)
+	}
+
+	proposalId, err := getExecutingProposalId(ctx, gk, p)
+	if err != nil {
+		return err
+	}
+
+	did.Redact(p.RedactedFields)
+
+	// The deactivated state is a new version, so the previous one stays in the history
+	metadata.UpdateVersion(ctx)
+	metadata.Deactivated = true
+	metadata.DeactivationProposalId = proposalId
+	metadata.RedactedFields = p.RedactedFields
+
+	// Deactivated DIDs can't be trusted issuers
+	for _, trustedIssuer := range k.GetTrustedIssuersByIssuer(ctx, p.Did) {
+		k.RemoveTrustedIssuer(ctx, trustedIssuer.Ref())
+	}
+
+	// Redacted content is removed from the previous versions as well
+	if err = k.RedactDidVersions(&ctx, p.Did, p.RedactedFields); err != nil {
+		return err
+	}
+
+	if err = k.SetDid(&ctx, did, &metadata); err != nil {
+		return err
+	}
+
+	return keeper.EmitDidDeactivatedEvent(ctx, *did, *stateValue.Metadata, metadata)
+}
+
+func handleAddTrustedIssuersProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddTrustedIssuersProposal) error {
+	for _, trustedIssuer := range p.TrustedIssuers {
+		if err := k.ValidateTrustedIssuerDid(ctx, trustedIssuer.Issuer); err != nil {
+			return err
+		}
+
+		k.SetTrustedIssuer(ctx, *trustedIssuer)
+	}
+
+	return nil
+}
+
+func handleRemoveTrustedIssuersProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveTrustedIssuersProposal) error {
+	for _, ref := range p.TrustedIssuers {
+		if _, found := k.GetTrustedIssuer(ctx, *ref); !found {
+			return types.ErrTrustedIssuerNotFound.Wrapf("issuer: %s, credential type: %s", ref.Issuer, ref.CredentialType)
+		}
+
+		k.RemoveTrustedIssuer(ctx, *ref)
+	}
+
+	return nil
+}
+
+func handleSetReservedIdsProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetReservedIdsProposal) error {
+	for _, reservedId := range p.ReservedIds {
+		if k.HasDid(&ctx, reservedId.Did) {
+			return types.ErrDidDocExists.Wrap(reservedId.Did)
+		}
+
+		k.SetReservedId(ctx, *reservedId)
+	}
+
+	return nil
+}
+
+func handleReleaseReservedIdsProposal(ctx sdk.Context, k keeper.Keeper, p *types.ReleaseReservedIdsProposal) error {
+	for _, did := range p.Dids {
+		if _, found := k.GetReservedId(ctx, did); !found {
+			return types.ErrReservedIdNotFound.Wrap(did)
+		}
+
+		k.RemoveReservedId(ctx, did)
+	}
+
+	return nil
+}
+
+// getExecutingProposalId finds the id of the proposal being executed.
+// Gov handlers don't get the id, but gov executes ended proposals in the order of the active queue
+// and removes each of them from the queue after execution, so the proposal being executed
+// is the first ended one that is still in the voting period.
+func getExecutingProposalId(ctx sdk.Context, gk types.GovKeeper, content proto.Message) (uint64, error) {
+	var (
+		found    bool
+		proposal govtypes.Proposal
+	)
+
+	gk.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(p govtypes.Proposal) bool {
+		if p.Status != govtypes.StatusVotingPeriod {
+			return false
+		}
+
+		found, proposal = true, p
+		return true
+	})
+
+	if !found {
+		return 0, types.ErrProposalNotFound.Wrap("no ended proposals in the active queue")
+	}
+
+	existing, ok := proposal.GetContent().(proto.Message)
+	if !ok || !proto.Equal(existing, content) {
+		return 0, types.ErrProposalNotFound.Wrapf("proposal %d doesn't match the executed content", proposal.ProposalId)
+	}
+
+	return proposal.ProposalId, nil
+}
@@ -4,6 +4,7 @@ const (
 	AliceDID     = "did:cheqd:test:aaaaaaaaaaaaaaaa"
 	BobDID       = "did:cheqd:test:bbbbbbbbbbbbbbbb"
 	CharlieDID   = "did:cheqd:test:cccccccccccccccc"
+	EveDID       = "did:cheqd:test:eeeeeeeeeeeeeeee"
 	ImposterDID  = "did:cheqd:test:nananananananana"
 	NotFounDID   = "did:cheqd:test:nfdnfdnfdnfdnfdd"
 	AliceKey1    = AliceDID + "#key-1"
@@ -19,4 +20,24 @@ const (
 	CharlieKey2  = CharlieDID + "#key-2"
 	CharlieKey3  = CharlieDID + "#key-3"
 	CharlieKey4  = CharlieDID + "#key-4"
+	EveKey1      = EveDID + "#key-1"
+)
+
+// Ids of ledger objects
+const (
+	SchemaId       = "did:cheqd:test:ssssssssssssssss"
+	CredDefId      = "did:cheqd:test:dddddddddddddddd"
+	RevocRegDefId  = "did:cheqd:test:rrrrrrrrrrrrrrrr"
+	StatusListId   = "did:cheqd:test:tttttttttttttttt"
+	LedgerObjectId = "did:cheqd:test:xxxxxxxxxxxxxxxx"
+	ResourceId1    = "9fbb1b86-91f8-4942-97b9-725b7714131c"
+	ResourceId2    = "6f8b3d62-6a3b-4d0c-9d24-4e1b7c2a8f10"
+	ResourceId3    = "c1a1a4d6-3f3e-4a0b-8f0e-2d5b1f7e9a21"
+	ResourceId4    = "0d4b6f22-8c9e-4b7a-a1d3-5e6f7a8b9c0d"
+)
+
+const (
+	CredDefValue     = `{"primary": {"n": "779...397", "s": "750..893", "r": {"name": "541...450"}, "rctxt": "774...977", "z": "632...005"}}`
+	RevocRegDefValue = `{"issuanceType": "ISSUANCE_BY_DEFAULT", "maxCredNum": 5, "publicKeys": {"accumKey": {"z": "1 0BB...386"}}, "tailsHash": "3MLj...RBJv", "tailsLocation": "https://tails.example.com/3MLj...RBJv"}`
+	StatusListSize   = 131072
 )
@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDidBatch(t *testing.T) {
+	const (
+		OrgDID     = "did:cheqd:test:oooooooooooooooo"
+		MemberDID  = "did:cheqd:test:mmmmmmmmmmmmmmmm"
+		OrgKey1    = OrgDID + "#key-1"
+		MemberKey1 = MemberDID + "#key-1"
+	)
+
+	cases := []struct {
+		valid   bool
+		name    string
+		prepare func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload
+		signers []string
+		errMsg  string
+	}{
+		{
+			valid: true,
+			name:  "Valid: Organisation DID controls a member DID created in the same batch",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+				member := setup.CreateDid(keys[MemberKey1].PublicKey, MemberDID)
+				member.Controller = []string{OrgDID}
+				member.VerificationMethod[0].Controller = OrgDID
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{member, org}}
+			},
+			signers: []string{OrgKey1},
+		},
+		{
+			valid: true,
+			name:  "Valid: Batch DID controlled by an existing DID",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+				member := setup.CreateDid(keys[MemberKey1].PublicKey, MemberDID)
+				member.Controller = []string{AliceDID}
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{org, member}}
+			},
+			signers: []string{OrgKey1, MemberKey1, AliceKey1},
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Signature of the batch controller is missing",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+				member := setup.CreateDid(keys[MemberKey1].PublicKey, MemberDID)
+				member.Controller = []string{OrgDID}
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{org, member}}
+			},
+			signers: []string{MemberKey1},
+			errMsg:  fmt.Sprintf("signer: %s: signature is required but not found", OrgDID),
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Controller is neither in the batch nor in the state",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+				member := setup.CreateDid(keys[MemberKey1].PublicKey, MemberDID)
+				member.Controller = []string{NotFounDID}
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{org, member}}
+			},
+			signers: []string{OrgKey1, MemberKey1},
+			errMsg:  fmt.Sprintf("%s: DID Doc not found", NotFounDID),
+		},
+		{
+			valid: false,
+			name:  "Not Valid: DID already exists",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+				alice := setup.CreateDid(keys[AliceKey1].PublicKey, AliceDID)
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{org, alice}}
+			},
+			signers: []string{OrgKey1, AliceKey1},
+			errMsg:  fmt.Sprintf("%s: DID Doc exists", AliceDID),
+		},
+		{
+			valid: false,
+			name:  "Not Valid: DID is duplicated in the batch",
+			prepare: func(setup *TestSetup, keys map[string]KeyPair) *types.MsgCreateDidBatchPayload {
+				org := setup.CreateDid(keys[OrgKey1].PublicKey, OrgDID)
+
+				return &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{org, org}}
+			},
+			signers: []string{OrgKey1},
+			errMsg:  "payload: (dids: there are DID duplicates.).: DID namespace validation failed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			keys := GenerateTestKeys()
+			keys[OrgKey1] = GenerateKeyPair()
+			keys[MemberKey1] = GenerateKeyPair()
+			require.NoError(t, setup.CreateTestDIDs(keys))
+
+			msg := tc.prepare(&setup, keys)
+
+			var signerKeys []SignerKey
+			for _, signer := range tc.signers {
+				signerKeys = append(signerKeys, SignerKey{signer: signer, key: keys[signer].PrivateKey})
+			}
+
+			dids, err := setup.SendCreateDidBatch(msg, signerKeys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, len(msg.Dids), len(dids))
+				for i, did := range dids {
+					require.Equal(t, msg.Dids[i].Id, did.Id)
+					require.Equal(t, msg.Dids[i].Controller, did.Controller)
+				}
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+
+				// Nothing from the batch must be written
+				require.False(t, setup.Keeper.HasDid(&setup.Ctx, OrgDID))
+				require.False(t, setup.Keeper.HasDid(&setup.Ctx, MemberDID))
+			}
+		})
+	}
+}
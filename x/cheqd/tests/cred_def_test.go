@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCredDef(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	_, err = setup.SendCreateSchema(NewSchemaPayload(SchemaId, BobDID), MapToListOfSignerKeys(bobKeys))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name       string
+		id         string
+		schemaId   string
+		controller []string
+		signers    []SignerKey
+		err        error
+		errMsg     string
+	}{
+		{
+			name:       "Valid: Issuer creates a cred def for a schema of someone else",
+			id:         "did:cheqd:test:1111111111111111",
+			schemaId:   SchemaId,
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+		},
+		{
+			name:       "Not Valid: Schema doesn't exist",
+			id:         "did:cheqd:test:2222222222222222",
+			schemaId:   "did:cheqd:test:zzzzzzzzzzzzzzzz",
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+			err:        types.ErrSchemaNotFound,
+			errMsg:     "did:cheqd:test:zzzzzzzzzzzzzzzz: schema not found",
+		},
+		{
+			name:       "Not Valid: Schema id is a DID",
+			id:         "did:cheqd:test:3333333333333333",
+			schemaId:   AliceDID,
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+			err:        types.ErrSchemaNotFound,
+		},
+		{
+			name:       "Not Valid: Issuer didn't sign",
+			id:         "did:cheqd:test:4444444444444444",
+			schemaId:   SchemaId,
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(bobKeys),
+			err:        types.ErrSignatureNotFound,
+			errMsg:     "signer: did:cheqd:test:aaaaaaaaaaaaaaaa: signature is required but not found",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewCredDefPayload(tc.id, tc.schemaId, tc.controller...)
+
+			credDef, err := setup.SendCreateCredDef(msg, tc.signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, msg.ToCredDef(), *credDef)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				if tc.errMsg != "" {
+					require.Equal(t, tc.errMsg, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestCreateCredDefIsImmutable(t *testing.T) {
+	setup := Setup()
+
+	signers, err := setup.InitCredDef()
+	require.NoError(t, err)
+
+	updated := NewCredDefPayload(CredDefId, SchemaId, AliceDID)
+	updated.Tag = "updated"
+
+	_, err = setup.SendCreateCredDef(updated, signers)
+	require.ErrorIs(t, err, types.ErrCredDefExists)
+}
+
+func TestQueryCredDefs(t *testing.T) {
+	setup := Setup()
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	_, err = setup.SendCreateSchema(NewSchemaPayload(SchemaId, AliceDID), MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	aliceAndBob := append(MapToListOfSignerKeys(aliceKeys), MapToListOfSignerKeys(bobKeys)...)
+	for _, msg := range []struct {
+		payload *types.MsgCreateCredDefPayload
+		signers []SignerKey
+	}{
+		{NewCredDefPayload("did:cheqd:test:1111111111111111", SchemaId, AliceDID), MapToListOfSignerKeys(aliceKeys)},
+		{NewCredDefPayload("did:cheqd:test:2222222222222222", SchemaId, AliceDID, BobDID), aliceAndBob},
+		{NewCredDefPayload("did:cheqd:test:3333333333333333", SchemaId, BobDID), MapToListOfSignerKeys(bobKeys)},
+	} {
+		_, err = setup.SendCreateCredDef(msg.payload, msg.signers)
+		require.NoError(t, err)
+	}
+
+	// By id
+	resp, err := setup.Keeper.CredDef(ctx, &types.QueryGetCredDefRequest{Id: "did:cheqd:test:3333333333333333"})
+	require.NoError(t, err)
+	require.Equal(t, []string{BobDID}, resp.CredDef.Controller)
+	require.NotEmpty(t, resp.Metadata.Created)
+
+	_, err = setup.Keeper.CredDef(ctx, &types.QueryGetCredDefRequest{Id: CredDefId})
+	require.ErrorIs(t, err, types.ErrCredDefNotFound)
+
+	// By issuer
+	getIds := func(credDefs []*types.CredDef) (ids []string) {
+		for _, credDef := range credDefs {
+			ids = append(ids, credDef.Id)
+		}
+		return
+	}
+
+	byAlice, err := setup.Keeper.CredDefsByIssuer(ctx, &types.QueryCredDefsByIssuerRequest{Issuer: AliceDID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"did:cheqd:test:1111111111111111", "did:cheqd:test:2222222222222222"}, getIds(byAlice.CredDefs))
+
+	byBob, err := setup.Keeper.CredDefsByIssuer(ctx, &types.QueryCredDefsByIssuerRequest{
+		Issuer:     BobDID,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"did:cheqd:test:2222222222222222"}, getIds(byBob.CredDefs))
+	require.Equal(t, uint64(2), byBob.Pagination.Total)
+
+	byBob, err = setup.Keeper.CredDefsByIssuer(ctx, &types.QueryCredDefsByIssuerRequest{
+		Issuer:     BobDID,
+		Pagination: &query.PageRequest{Key: byBob.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"did:cheqd:test:3333333333333333"}, getIds(byBob.CredDefs))
+
+	byCharlie, err := setup.Keeper.CredDefsByIssuer(ctx, &types.QueryCredDefsByIssuerRequest{Issuer: CharlieDID})
+	require.NoError(t, err)
+	require.Empty(t, byCharlie.CredDefs)
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateDidProposal(t *testing.T) {
+	cases := []struct {
+		name           string
+		redactedFields []string
+	}{
+		{"Deactivate without redaction", nil},
+		{"Deactivate and redact service", []string{types.DidFieldService}},
+		{"Deactivate and redact all the fields", []string{types.DidFieldService, types.DidFieldAlsoKnownAs}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			_, msg, err := setup.InitDid(AliceDID)
+			require.NoError(t, err)
+
+			// Another proposal is processed first, so the id isn't trivial
+			_, err = setup.SubmitProposal(types.NewSetNamespacesProposal("title", "description", []*types.Namespace{
+				{Name: NamespaceMainnet, Status: types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE},
+			}))
+			require.NoError(t, err)
+
+			id, err := setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, tc.redactedFields))
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), id)
+
+			state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+			require.NoError(t, err)
+
+			require.True(t, state.Metadata.Deactivated)
+			require.Equal(t, id, state.Metadata.DeactivationProposalId)
+			require.Equal(t, tc.redactedFields, state.Metadata.RedactedFields)
+			require.NotEmpty(t, state.Metadata.Updated)
+
+			did, err := state.UnpackDataAsDid()
+			require.NoError(t, err)
+
+			expected := msg.ToDid()
+			expected.Redact(tc.redactedFields)
+			require.Equal(t, &expected, did)
+		})
+	}
+}
+
+func TestDeactivateDidProposalFails(t *testing.T) {
+	setup := Setup()
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", NotFounDID, nil))
+	require.ErrorIs(t, err, types.ErrDidDocNotFound)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, []string{"verificationMethod"}))
+	require.ErrorIs(t, err, types.ErrBasicValidation)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.NoError(t, err)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.ErrorIs(t, err, types.ErrDidDocDeactivated)
+}
+
+func TestDeactivatedDidCantBeUpdated(t *testing.T) {
+	setup := Setup()
+	aliceKeys, aliceMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.NoError(t, err)
+
+	// Updates of the deactivated DID
+	updateMsg := setup.CreateToUpdateDid(aliceMsg)
+	updateMsg.AlsoKnownAs = []string{"did:example:alice"}
+
+	_, err = setup.SendUpdateDid(updateMsg, MapToListOfSignerKeys(aliceKeys))
+	require.ErrorIs(t, err, types.ErrDidDocDeactivated)
+
+	// Keys of the deactivated DID can't control other DIDs
+	bobMsg := setup.CreateDid(GenerateKeyPair().PublicKey, BobDID)
+	bobMsg.Controller = []string{AliceDID}
+
+	_, err = setup.SendCreateDid(bobMsg, aliceKeys)
+	require.ErrorIs(t, err, types.ErrDidDocDeactivated)
+}
+
+func TestDeactivateDidProposalIdentical(t *testing.T) {
+	setup := Setup()
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// An identical proposal is in the queue, but it hasn't ended yet
+	pending, err := govtypes.NewProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil), 100, setup.Ctx.BlockTime(), setup.Ctx.BlockTime())
+	require.NoError(t, err)
+	pending.Status = govtypes.StatusVotingPeriod
+	pending.VotingEndTime = setup.Ctx.BlockTime().Add(time.Hour)
+	setup.GovKeeper.proposals = append(setup.GovKeeper.proposals, pending)
+
+	id, err := setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.Equal(t, id, state.Metadata.DeactivationProposalId)
+}
+
+func TestDeactivateDidProposalNotInQueue(t *testing.T) {
+	setup := Setup()
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// The proposal isn't the first ended one of the active queue
+	handler := cheqd.NewProposalHandler(setup.Keeper, setup.GovKeeper)
+	err = handler(setup.Ctx, types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.ErrorIs(t, err, types.ErrProposalNotFound)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.False(t, state.Metadata.Deactivated)
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// initDidVersions creates Alice's DID and updates it in another tx, returning both version ids
+func initDidVersions(t *testing.T, setup *TestSetup) (string, string) {
+	aliceKeys, aliceMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	key2 := GenerateKeyPair()
+
+	updateMsg := setup.CreateToUpdateDid(aliceMsg)
+	updateMsg.VerificationMethod = append(updateMsg.VerificationMethod, &types.VerificationMethod{
+		Id:                 AliceKey2,
+		Type:               Ed25519VerificationKey2020,
+		Controller:         AliceDID,
+		PublicKeyMultibase: "z" + base58.Encode(key2.PublicKey),
+	})
+	updateMsg.Authentication = []string{AliceKey2}
+	updateMsg.Service = nil
+	updateMsg.AlsoKnownAs = []string{"did:example:alice"}
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("update tx"))
+
+	_, err = setup.SendUpdateDid(updateMsg, []SignerKey{{signer: AliceKey1, key: aliceKeys[AliceKey1]}})
+	require.NoError(t, err)
+
+	return state.Metadata.VersionId, types.NextVersionId(setup.Ctx, state.Metadata.VersionId)
+}
+
+func TestDidDiff(t *testing.T) {
+	setup := Setup()
+	v1, v2 := initDidVersions(t, &setup)
+
+	resp, err := setup.Keeper.DidDiff(sdk.WrapSDKContext(setup.Ctx), &types.QueryDidDiffRequest{Id: AliceDID, FromVersion: v1, ToVersion: v2})
+	require.NoError(t, err)
+	require.Equal(t, &types.DidDiff{
+		Id:                 AliceDID,
+		FromVersionId:      v1,
+		ToVersionId:        v2,
+		VerificationMethod: &types.DidEntryDiff{Added: []string{AliceKey2}},
+		Authentication:     &types.DidListDiff{Added: []string{AliceKey2}, Removed: []string{AliceKey1}},
+		Service:            &types.DidEntryDiff{Removed: []string{AliceDID + "#service-2"}},
+		AlsoKnownAs:        &types.DidListDiff{Added: []string{"did:example:alice"}, Removed: []string{AliceKey1}},
+	}, resp.Diff)
+
+	// The same version
+	resp, err = setup.Keeper.DidDiff(sdk.WrapSDKContext(setup.Ctx), &types.QueryDidDiffRequest{Id: AliceDID, FromVersion: v2, ToVersion: v2})
+	require.NoError(t, err)
+	require.True(t, resp.Diff.IsEmpty())
+
+	_, err = setup.Keeper.DidDiff(sdk.WrapSDKContext(setup.Ctx), &types.QueryDidDiffRequest{Id: AliceDID, FromVersion: v1, ToVersion: "unknown"})
+	require.ErrorIs(t, err, types.ErrDidVersionNotFound)
+
+	_, err = setup.Keeper.DidDiff(sdk.WrapSDKContext(setup.Ctx), &types.QueryDidDiffRequest{Id: NotFounDID, FromVersion: v1, ToVersion: v2})
+	require.ErrorIs(t, err, types.ErrDidVersionNotFound)
+}
+
+func TestDidVersionsAreRedacted(t *testing.T) {
+	setup := Setup()
+	v1, v2 := initDidVersions(t, &setup)
+
+	_, err := setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, []string{types.DidFieldAlsoKnownAs}))
+	require.NoError(t, err)
+
+	for _, version := range []string{v1, v2} {
+		state, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, version)
+		require.NoError(t, err)
+
+		did, err := state.UnpackDataAsDid()
+		require.NoError(t, err)
+		require.Empty(t, did.AlsoKnownAs)
+	}
+
+	// The deactivation is a separate version, the previous ones are only redacted
+	state, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, v2)
+	require.NoError(t, err)
+	require.False(t, state.Metadata.Deactivated)
+
+	latest, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.NotEqual(t, v2, latest.Metadata.VersionId)
+
+	deactivated, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, latest.Metadata.VersionId)
+	require.NoError(t, err)
+	require.True(t, deactivated.Metadata.Deactivated)
+
+	resp, err := setup.Keeper.DidDiff(sdk.WrapSDKContext(setup.Ctx), &types.QueryDidDiffRequest{Id: AliceDID, FromVersion: v1, ToVersion: v2})
+	require.NoError(t, err)
+	require.Nil(t, resp.Diff.AlsoKnownAs)
+}
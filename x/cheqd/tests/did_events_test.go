@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func findTypedEvents(t *testing.T, events []abci.Event, eventType proto.Message) []proto.Message {
+	var res []proto.Message
+
+	for _, event := range events {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+
+		res = append(res, msg)
+	}
+
+	return res
+}
+
+func TestDidCreatedEvent(t *testing.T) {
+	setup := Setup()
+	keys := GenerateKeyPair()
+
+	msg := setup.CreateDid(keys.PublicKey, AliceDID)
+	result, err := setup.Handler(setup.Ctx, setup.WrapCreateRequest(msg, map[string]ed25519.PrivateKey{AliceKey1: keys.PrivateKey}))
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	events := findTypedEvents(t, result.Events, &types.EventDidCreated{})
+	require.Equal(t, []proto.Message{
+		&types.EventDidCreated{
+			Id:        AliceDID,
+			VersionId: state.Metadata.VersionId,
+			Signers:   []string{AliceDID},
+		},
+	}, events)
+}
+
+func TestDidUpdatedEvent(t *testing.T) {
+	setup := Setup()
+	aliceKeys, aliceMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	existing, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	updateMsg := setup.CreateToUpdateDid(aliceMsg)
+	updateMsg.VersionId = existing.Metadata.VersionId
+	updateMsg.Controller = []string{AliceDID, BobDID}
+	updateMsg.AlsoKnownAs = []string{"did:example:alice"}
+
+	// The update is executed in another transaction, so it gets a new version id
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("update tx"))
+	result, err := setup.Handler(setup.Ctx, setup.WrapUpdateRequest(updateMsg, []SignerKey{
+		{signer: AliceKey1, key: aliceKeys[AliceKey1]},
+		{signer: BobKey1, key: bobKeys[BobKey1]},
+	}))
+	require.NoError(t, err)
+
+	updated, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.Equal(t, types.NextVersionId(setup.Ctx, existing.Metadata.VersionId), updated.Metadata.VersionId)
+	require.NotEqual(t, existing.Metadata.VersionId, updated.Metadata.VersionId)
+
+	events := findTypedEvents(t, result.Events, &types.EventDidUpdated{})
+	require.Equal(t, []proto.Message{
+		&types.EventDidUpdated{
+			Id:                AliceDID,
+			VersionId:         updated.Metadata.VersionId,
+			PreviousVersionId: existing.Metadata.VersionId,
+			Signers:           []string{AliceDID, BobDID},
+			ChangedFields:     []string{types.PatchFieldController, types.PatchFieldAlsoKnownAs},
+		},
+	}, events)
+}
+
+func TestDidDeactivatedEvent(t *testing.T) {
+	setup := Setup()
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	existing, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	id, err := setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, []string{types.DidFieldService}))
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.NotEqual(t, existing.Metadata.VersionId, state.Metadata.VersionId)
+
+	events := findTypedEvents(t, setup.Ctx.EventManager().ABCIEvents(), &types.EventDidDeactivated{})
+	require.Equal(t, []proto.Message{
+		&types.EventDidDeactivated{
+			Id:                AliceDID,
+			VersionId:         state.Metadata.VersionId,
+			ProposalId:        id,
+			RedactedFields:    []string{types.DidFieldService},
+			PreviousVersionId: existing.Metadata.VersionId,
+		},
+	}, events)
+}
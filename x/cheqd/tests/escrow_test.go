@@ -0,0 +1,309 @@
+package tests
+
+import (
+	"crypto/rand"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	RequestId      = "5d5bfb0e-2a5d-4b8e-8f5a-1c3e3f7f6a21"
+	PaymentTimeout = 3600
+)
+
+var (
+	HolderAddr    = sdk.AccAddress([]byte("holder______________"))
+	RecipientAddr = sdk.AccAddress([]byte("recipient___________"))
+	AttackerAddr  = sdk.AccAddress([]byte("attacker____________"))
+)
+
+func Payment(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(types.BaseDenom, amount)
+}
+
+func InitEscrow(t *testing.T, setup *TestSetup, requestId string, issuer string) {
+	setup.BankKeeper.balances[HolderAddr.String()] = setup.BankKeeper.balances[HolderAddr.String()].Add(Payment(1000))
+
+	msg := types.NewMsgLockPayment(HolderAddr.String(), requestId, issuer, Payment(1000), PaymentTimeout)
+	_, err := setup.Handler(setup.Ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestLockPayment(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	setup.BankKeeper.balances[HolderAddr.String()] = sdk.NewCoins(Payment(1500))
+
+	cases := []struct {
+		name string
+		msg  *types.MsgLockPayment
+		err  error
+	}{
+		{
+			name: "Valid: Holder locks a payment",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), RequestId, AliceDID, Payment(1000), PaymentTimeout),
+		},
+		{
+			name: "Not Valid: Holder has already locked a payment for the request",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), RequestId, AliceDID, Payment(100), PaymentTimeout),
+			err:  types.ErrEscrowExists,
+		},
+		{
+			name: "Not Valid: Timeout is too short",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), "request-5", AliceDID, Payment(100), types.EscrowMinTimeout-1),
+			err:  types.ErrNamespaceValidation,
+		},
+		{
+			name: "Not Valid: Timeout is too long",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), "request-6", AliceDID, Payment(100), types.EscrowMaxTimeout+1),
+			err:  types.ErrNamespaceValidation,
+		},
+		{
+			name: "Not Valid: Issuer doesn't exist",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), "request-2", NotFounDID, Payment(100), PaymentTimeout),
+			err:  types.ErrDidDocNotFound,
+		},
+		{
+			name: "Not Valid: Holder doesn't have enough funds",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), "request-3", AliceDID, Payment(1000), PaymentTimeout),
+			err:  sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			name: "Not Valid: Payment isn't in ncheq",
+			msg:  types.NewMsgLockPayment(HolderAddr.String(), "request-4", AliceDID, sdk.NewInt64Coin("stake", 100), PaymentTimeout),
+			err:  types.ErrNamespaceValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := setup.Handler(setup.Ctx, tc.msg)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	resp, err := setup.Keeper.Escrow(sdk.WrapSDKContext(setup.Ctx), &types.QueryGetEscrowRequest{Issuer: AliceDID, Holder: HolderAddr.String(), RequestId: RequestId})
+	require.NoError(t, err)
+	require.Equal(t, types.EscrowStatus_ESCROW_STATUS_LOCKED, resp.Escrow.Status)
+	require.Equal(t, AliceDID, resp.Escrow.Issuer)
+	require.Equal(t, HolderAddr.String(), resp.Escrow.Holder)
+	require.Equal(t, setup.Ctx.BlockTime().Unix()+PaymentTimeout, resp.Escrow.ExpiresAt)
+
+	require.Equal(t, int64(500), setup.BankKeeper.GetBalance(HolderAddr))
+	require.Equal(t, int64(1000), setup.BankKeeper.GetBalance(setup.BankKeeper.GetModuleAddress(types.EscrowAccountName)))
+}
+
+func TestLockPaymentRequestIdOfAnotherHolder(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// Someone locks a tiny payment for the request id first
+	setup.BankKeeper.balances[AttackerAddr.String()] = sdk.NewCoins(Payment(1))
+	_, err = setup.Handler(setup.Ctx, types.NewMsgLockPayment(AttackerAddr.String(), RequestId, AliceDID, Payment(1), types.EscrowMaxTimeout))
+	require.NoError(t, err)
+
+	// The holder can still lock and the issuer claims the holder's payment
+	InitEscrow(t, &setup, RequestId, AliceDID)
+
+	_, err = setup.Handler(setup.Ctx, setup.WrapClaimPaymentRequest(&types.MsgClaimPaymentPayload{
+		RequestId: RequestId,
+		Recipient: RecipientAddr.String(),
+		Issuer:    AliceDID,
+		Holder:    HolderAddr.String(),
+	}, MapToListOfSignerKeys(aliceKeys)))
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), setup.BankKeeper.GetBalance(RecipientAddr))
+
+	attackerEscrow, err := setup.Keeper.GetEscrow(setup.Ctx, AliceDID, AttackerAddr.String(), RequestId)
+	require.NoError(t, err)
+	require.Equal(t, types.EscrowStatus_ESCROW_STATUS_LOCKED, attackerEscrow.Status)
+}
+
+func TestEscrowExpiresAt(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+
+	expiresAt, err := types.GetEscrowExpiresAt(blockTime, types.EscrowMaxTimeout)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000+types.EscrowMaxTimeout), expiresAt)
+
+	for _, timeout := range []uint64{math.MaxInt64 - 999, math.MaxInt64 + 1, math.MaxUint64} {
+		_, err = types.GetEscrowExpiresAt(blockTime, timeout)
+		require.Error(t, err, timeout)
+	}
+}
+
+func TestClaimPayment(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// Bob's key is an authentication key only
+	bobPubKey, bobPrivKey, _ := ed25519.GenerateKey(rand.Reader)
+	bobDid := setup.CreateDid(bobPubKey, BobDID)
+	bobDid.AssertionMethod = nil
+	bobKeys := map[string]ed25519.PrivateKey{BobDID + "#key-1": bobPrivKey}
+	_, err = setup.SendCreateDid(bobDid, bobKeys)
+	require.NoError(t, err)
+
+	InitEscrow(t, &setup, RequestId, AliceDID)
+	InitEscrow(t, &setup, "bob-request", BobDID)
+	InitEscrow(t, &setup, "expired-request", AliceDID)
+
+	claim := func(requestId string, issuer string) *types.MsgClaimPaymentPayload {
+		return &types.MsgClaimPaymentPayload{RequestId: requestId, Recipient: RecipientAddr.String(), Issuer: issuer, Holder: HolderAddr.String()}
+	}
+
+	cases := []struct {
+		name      string
+		payload   *types.MsgClaimPaymentPayload
+		signers   []SignerKey
+		blockTime time.Time
+		err       error
+	}{
+		{
+			name:    "Not Valid: Signed by someone else",
+			payload: claim(RequestId, AliceDID),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrSignatureNotFound,
+		},
+		{
+			name:    "Not Valid: Payment is locked to another issuer",
+			payload: claim(RequestId, BobDID),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrEscrowNotFound,
+		},
+		{
+			name:    "Not Valid: Issuer signs with a key that isn't an assertion method",
+			payload: claim("bob-request", BobDID),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrSignatureNotFound,
+		},
+		{
+			name:    "Valid: Issuer signs with an assertion key",
+			payload: claim(RequestId, AliceDID),
+			signers: MapToListOfSignerKeys(aliceKeys),
+		},
+		{
+			name:    "Not Valid: Payment is already claimed",
+			payload: claim(RequestId, AliceDID),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrEscrowNotLocked,
+		},
+		{
+			name:    "Not Valid: Payment doesn't exist",
+			payload: claim("unknown-request", AliceDID),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrEscrowNotFound,
+		},
+		{
+			name:      "Not Valid: Payment is expired",
+			payload:   claim("expired-request", AliceDID),
+			signers:   MapToListOfSignerKeys(aliceKeys),
+			blockTime: setup.Ctx.BlockTime().Add(PaymentTimeout * time.Second),
+			err:       types.ErrEscrowExpired,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := setup.Ctx
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+
+			_, err := setup.Handler(ctx, setup.WrapClaimPaymentRequest(tc.payload, tc.signers))
+
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	escrow, err := setup.Keeper.GetEscrow(setup.Ctx, AliceDID, HolderAddr.String(), RequestId)
+	require.NoError(t, err)
+	require.Equal(t, types.EscrowStatus_ESCROW_STATUS_CLAIMED, escrow.Status)
+	require.Equal(t, RecipientAddr.String(), escrow.Recipient)
+
+	require.Equal(t, int64(1000), setup.BankKeeper.GetBalance(RecipientAddr))
+	require.Equal(t, int64(2000), setup.BankKeeper.GetBalance(setup.BankKeeper.GetModuleAddress(types.EscrowAccountName)))
+}
+
+func TestReclaimPayment(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	InitEscrow(t, &setup, RequestId, AliceDID)
+	expiresAt := setup.Ctx.BlockTime().Add(PaymentTimeout * time.Second)
+
+	cases := []struct {
+		name      string
+		msg       *types.MsgReclaimPayment
+		blockTime time.Time
+		err       error
+	}{
+		{
+			name:      "Not Valid: Payment isn't expired",
+			msg:       types.NewMsgReclaimPayment(HolderAddr.String(), RequestId, AliceDID),
+			blockTime: expiresAt.Add(-time.Second),
+			err:       types.ErrEscrowNotExpired,
+		},
+		{
+			name:      "Not Valid: Reclaimed by someone else",
+			msg:       types.NewMsgReclaimPayment(RecipientAddr.String(), RequestId, AliceDID),
+			blockTime: expiresAt,
+			err:       types.ErrEscrowNotFound,
+		},
+		{
+			name:      "Valid: Holder reclaims the expired payment",
+			msg:       types.NewMsgReclaimPayment(HolderAddr.String(), RequestId, AliceDID),
+			blockTime: expiresAt,
+		},
+		{
+			name:      "Not Valid: Payment is already reclaimed",
+			msg:       types.NewMsgReclaimPayment(HolderAddr.String(), RequestId, AliceDID),
+			blockTime: expiresAt,
+			err:       types.ErrEscrowNotLocked,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := setup.Handler(setup.Ctx.WithBlockTime(tc.blockTime), tc.msg)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	escrow, err := setup.Keeper.GetEscrow(setup.Ctx, AliceDID, HolderAddr.String(), RequestId)
+	require.NoError(t, err)
+	require.Equal(t, types.EscrowStatus_ESCROW_STATUS_RECLAIMED, escrow.Status)
+
+	require.Equal(t, int64(1000), setup.BankKeeper.GetBalance(HolderAddr))
+	require.Equal(t, int64(0), setup.BankKeeper.GetBalance(setup.BankKeeper.GetModuleAddress(types.EscrowAccountName)))
+}
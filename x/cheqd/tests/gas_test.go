@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentityGasConsumption(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[EveKey1] = GenerateKeyPair()
+
+	// Eve's DID is controlled by Alice and Eve, so both DIDs are resolved and both sign
+	buildMsg := func(setup TestSetup) *types.MsgCreateDidPayload {
+		msg := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+		msg.Controller = []string{AliceDID, EveDID}
+		return msg
+	}
+	signerKeys := map[string]ed25519.PrivateKey{
+		AliceKey1: keys[AliceKey1].PrivateKey,
+		EveKey1:   keys[EveKey1].PrivateKey,
+	}
+
+	consumeGas := func(gasParams types.GasParams) uint64 {
+		setup := Setup()
+		require.NoError(t, setup.CreateTestDIDs(keys))
+		setup.UpdateParams(func(params *types.Params) { params.GasParams = &gasParams })
+		setup.Ctx = setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		_, err := setup.SendCreateDid(buildMsg(setup), signerKeys)
+		require.NoError(t, err)
+
+		return setup.Ctx.GasMeter().GasConsumed()
+	}
+
+	// Param values of the same length are compared, as reading params consumes gas per byte
+	baseline := consumeGas(types.NewGasParams(1, 1, 1, 1, 1, 1))
+
+	t.Run("signature verification is charged per signature", func(t *testing.T) {
+		require.Equal(t, baseline+2, consumeGas(types.NewGasParams(2, 1, 1, 1, 1, 1)))
+	})
+
+	t.Run("controller resolutions are charged", func(t *testing.T) {
+		require.Less(t, baseline, consumeGas(types.NewGasParams(1, 1, 1, 1, 2, 1)))
+	})
+
+	t.Run("document is charged per byte", func(t *testing.T) {
+		did := buildMsg(Setup()).ToDid()
+		require.Equal(t, baseline+uint64(did.Size()), consumeGas(types.NewGasParams(1, 1, 1, 1, 1, 2)))
+	})
+}
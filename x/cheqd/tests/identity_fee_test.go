@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/ante"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+type TestFeeTx struct {
+	msgs  []sdk.Msg
+	payer sdk.AccAddress
+	gas   uint64
+}
+
+func (tx TestFeeTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx TestFeeTx) ValidateBasic() error       { return nil }
+func (tx TestFeeTx) GetGas() uint64             { return tx.gas }
+func (tx TestFeeTx) GetFee() sdk.Coins          { return nil }
+func (tx TestFeeTx) FeePayer() sdk.AccAddress   { return tx.payer }
+func (tx TestFeeTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestGetIdentityFee(t *testing.T) {
+	feeParams := types.DefaultFeeParams()
+	createFee := feeParams.CreateDid.Amount
+	updateFee := feeParams.UpdateDid.Amount
+	deactivateFee := feeParams.DeactivateDid.Amount
+
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&types.MsgUpdateDid{}})
+
+	deactivateProposal, err := govtypes.NewMsgSubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil), nil, sdk.AccAddress("proposer"))
+	require.NoError(t, err)
+
+	textProposal, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), nil, sdk.AccAddress("proposer"))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected sdk.Int
+	}{
+		{
+			name:     "no identity messages",
+			msgs:     []sdk.Msg{&banktypes.MsgSend{}},
+			expected: sdk.ZeroInt(),
+		},
+		{
+			name:     "create and patch",
+			msgs:     []sdk.Msg{&types.MsgCreateDid{}, &types.MsgPatchDid{}},
+			expected: createFee.Add(updateFee),
+		},
+		{
+			name: "batch is charged per DID",
+			msgs: []sdk.Msg{&types.MsgCreateDidBatch{Payload: &types.MsgCreateDidBatchPayload{
+				Dids: []*types.MsgCreateDidPayload{{}, {}, {}},
+			}}},
+			expected: createFee.MulRaw(3),
+		},
+		{
+			name:     "messages wrapped into authz exec are charged",
+			msgs:     []sdk.Msg{&exec},
+			expected: updateFee,
+		},
+		{
+			name:     "deactivate DID proposal",
+			msgs:     []sdk.Msg{deactivateProposal},
+			expected: deactivateFee,
+		},
+		{
+			name:     "other proposals are not charged",
+			msgs:     []sdk.Msg{textProposal},
+			expected: sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := ante.GetIdentityFee(tc.msgs, feeParams)
+			require.Equal(t, tc.expected.String(), fee.AmountOf(types.BaseDenom).String())
+		})
+	}
+}
+
+func TestDeductIdentityFeeDecorator(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	communityPool := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	cases := []struct {
+		valid         bool
+		name          string
+		balance       int64
+		burnt         int64
+		communityPool int64
+		feeCollector  int64
+		errMsg        string
+	}{
+		{
+			valid:         true,
+			name:          "Valid: Fee is split between burning, community pool and fee collector",
+			balance:       1000,
+			burnt:         50,
+			communityPool: 20,
+			feeCollector:  30,
+		},
+		{
+			valid:   false,
+			name:    "Not Valid: Insufficient funds",
+			balance: 10,
+			errMsg:  "identity fee 100ncheq: 10ncheq is smaller than 100ncheq: insufficient funds: insufficient funds",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			setup.UpdateParams(func(params *types.Params) {
+				fee := sdk.NewInt64Coin(types.BaseDenom, 100)
+				params.FeeParams.CreateDid = &fee
+				params.FeeParams.BurnFactor = "0.5"
+				params.FeeParams.CommunityPoolFactor = "0.2"
+			})
+
+			bk := NewTestBankKeeper()
+			bk.balances[payer.String()] = sdk.NewCoins(sdk.NewInt64Coin(types.BaseDenom, tc.balance))
+
+			decorator := ante.NewDeductIdentityFeeDecorator(bk, bk, bk, setup.Keeper)
+			anteHandler := sdk.ChainAnteDecorators(decorator)
+
+			tx := TestFeeTx{msgs: []sdk.Msg{&types.MsgCreateDid{}}, payer: payer}
+			_, err := anteHandler(setup.Ctx, tx, false)
+
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, tc.balance-100, bk.balances[payer.String()].AmountOf(types.BaseDenom).Int64())
+				require.Equal(t, tc.burnt, bk.burnt.AmountOf(types.BaseDenom).Int64())
+				require.Equal(t, tc.communityPool, bk.balances[communityPool].AmountOf(types.BaseDenom).Int64())
+				require.Equal(t, tc.feeCollector, bk.balances[feeCollector].AmountOf(types.BaseDenom).Int64())
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/ante"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentitySigVerificationDecorator(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[EveKey1] = GenerateKeyPair()
+
+	cases := []struct {
+		valid     bool
+		name      string
+		isCheckTx bool
+		signKey   ed25519.PrivateKey
+		errMsg    string
+	}{
+		{
+			valid:     true,
+			name:      "Valid: Correct signature in CheckTx",
+			isCheckTx: true,
+			signKey:   keys[EveKey1].PrivateKey,
+		},
+		{
+			valid:     false,
+			name:      "Not Valid: Wrong signature in CheckTx",
+			isCheckTx: true,
+			signKey:   keys[ImposterKey1].PrivateKey,
+			errMsg:    fmt.Sprintf("method id: %s: invalid signature detected", EveKey1),
+		},
+		{
+			valid:     true,
+			name:      "Valid: Signatures are left to the msg server in DeliverTx",
+			isCheckTx: false,
+			signKey:   keys[ImposterKey1].PrivateKey,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := InitEnv(t, keys)
+			anteHandler := sdk.ChainAnteDecorators(ante.NewIdentitySigVerificationDecorator(setup.Keeper))
+
+			payload := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+			msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{EveKey1: tc.signKey})
+			tx := TestFeeTx{msgs: []sdk.Msg{msg}}
+
+			_, err := anteHandler(setup.Ctx.WithIsCheckTx(tc.isCheckTx), tx, false)
+
+			if tc.valid {
+				require.NoError(t, err)
+				// Verification must not change the state
+				require.False(t, setup.Keeper.HasDid(&setup.Ctx, EveDID))
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestIdentitySigVerificationIsLimitedByTxGas(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[EveKey1] = GenerateKeyPair()
+	setup := InitEnv(t, keys)
+	anteHandler := sdk.ChainAnteDecorators(authante.NewSetUpContextDecorator(), ante.NewIdentitySigVerificationDecorator(setup.Keeper))
+
+	payload := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+	msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{EveKey1: keys[EveKey1].PrivateKey})
+
+	// Gas meters are limited starting from the first block
+	ctx := setup.Ctx.WithIsCheckTx(true).WithBlockHeight(1)
+	gasParams := setup.Keeper.GetParams(ctx).GasParams
+	verificationGas := gasParams.ControllerResolutionCost + gasParams.SigVerifyCostEd25519
+	cached := setup.Keeper.SignatureCache().Len()
+
+	_, err := anteHandler(ctx, TestFeeTx{msgs: []sdk.Msg{msg}, gas: verificationGas - 1}, false)
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Equal(t, cached, setup.Keeper.SignatureCache().Len())
+
+	newCtx, err := anteHandler(ctx, TestFeeTx{msgs: []sdk.Msg{msg}, gas: 200000}, false)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, newCtx.GasMeter().GasConsumed(), verificationGas)
+}
+
+func TestIdentitySignaturesAreCachedBetweenCheckTxAndDeliverTx(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[EveKey1] = GenerateKeyPair()
+	setup := InitEnv(t, keys)
+	anteHandler := sdk.ChainAnteDecorators(ante.NewIdentitySigVerificationDecorator(setup.Keeper))
+
+	payload := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+	msg := setup.WrapCreateRequest(payload, map[string]ed25519.PrivateKey{EveKey1: keys[EveKey1].PrivateKey})
+
+	cached := setup.Keeper.SignatureCache().Len()
+
+	_, err := anteHandler(setup.Ctx.WithIsCheckTx(true), TestFeeTx{msgs: []sdk.Msg{msg}}, false)
+	require.NoError(t, err)
+	require.Equal(t, cached+1, setup.Keeper.SignatureCache().Len())
+
+	// DeliverTx finds the signature in the cache
+	_, err = setup.Handler(setup.Ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, cached+1, setup.Keeper.SignatureCache().Len())
+	require.True(t, setup.Keeper.HasDid(&setup.Ctx, EveDID))
+}
+
+func TestSignatureCacheEviction(t *testing.T) {
+	cache := keeper.NewSignatureCache(2)
+	vm := types.VerificationMethod{Id: AliceKey1}
+
+	for i := 0; i < 3; i++ {
+		cache.Add(vm, []byte{byte(i)}, types.SignInfo{VerificationMethodId: AliceKey1})
+	}
+
+	require.Equal(t, 2, cache.Len())
+	require.False(t, cache.Has(vm, []byte{0}, types.SignInfo{VerificationMethodId: AliceKey1}))
+	require.True(t, cache.Has(vm, []byte{2}, types.SignInfo{VerificationMethodId: AliceKey1}))
+
+	// Any difference in the signature makes a different entry
+	require.False(t, cache.Has(vm, []byte{2}, types.SignInfo{VerificationMethodId: AliceKey1, Signature: "other"}))
+}
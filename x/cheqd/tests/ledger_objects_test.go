@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ledgerObject creates a ledger object with the given id, controlled by Alice
+type ledgerObject struct {
+	name      string
+	existsErr error
+	create    func(setup *TestSetup, id string, signers []SignerKey) error
+}
+
+var ledgerObjects = []ledgerObject{
+	{
+		name:      "Schema",
+		existsErr: types.ErrSchemaExists,
+		create: func(setup *TestSetup, id string, signers []SignerKey) error {
+			_, err := setup.SendCreateSchema(NewSchemaPayload(id, AliceDID), signers)
+			return err
+		},
+	},
+	{
+		name:      "CredDef",
+		existsErr: types.ErrCredDefExists,
+		create: func(setup *TestSetup, id string, signers []SignerKey) error {
+			_, err := setup.SendCreateCredDef(NewCredDefPayload(id, SchemaId, AliceDID), signers)
+			return err
+		},
+	},
+	{
+		name:      "RevocRegDef",
+		existsErr: types.ErrRevocRegDefExists,
+		create: func(setup *TestSetup, id string, signers []SignerKey) error {
+			_, err := setup.SendCreateRevocRegDef(NewRevocRegDefPayload(id, CredDefId, AliceDID), signers)
+			return err
+		},
+	},
+	{
+		name:      "StatusList",
+		existsErr: types.ErrStatusListExists,
+		create: func(setup *TestSetup, id string, signers []SignerKey) error {
+			_, err := setup.SendCreateStatusList(NewStatusListPayload(id, AliceDID, StatusListSize), signers)
+			return err
+		},
+	},
+}
+
+func TestCreateLedgerObjectReplay(t *testing.T) {
+	for _, object := range ledgerObjects {
+		t.Run(object.name, func(t *testing.T) {
+			setup := Setup()
+
+			signers, err := setup.InitCredDef()
+			require.NoError(t, err)
+
+			setup.Ctx = setup.Ctx.WithTxBytes([]byte("tx-1"))
+			err = object.create(&setup, LedgerObjectId, signers)
+			require.NoError(t, err)
+
+			// The same signed message is sent again in another tx
+			setup.Ctx = setup.Ctx.WithTxBytes([]byte("tx-2"))
+			err = object.create(&setup, LedgerObjectId, signers)
+			require.ErrorIs(t, err, object.existsErr)
+		})
+	}
+}
+
+func TestCreateLedgerObjectIdCollision(t *testing.T) {
+	for _, object := range ledgerObjects {
+		t.Run(object.name, func(t *testing.T) {
+			setup := Setup()
+
+			signers, err := setup.InitCredDef()
+			require.NoError(t, err)
+
+			// The id of a DID
+			err = object.create(&setup, AliceDID, signers)
+			require.ErrorIs(t, err, types.ErrIdInUse)
+
+			// The ids of the schema and the cred def of InitCredDef
+			for usedId, name := range map[string]string{SchemaId: "Schema", CredDefId: "CredDef"} {
+				err = object.create(&setup, usedId, signers)
+				if name == object.name {
+					require.ErrorIs(t, err, object.existsErr)
+				} else {
+					require.ErrorIs(t, err, types.ErrIdInUse)
+				}
+			}
+
+			err = object.create(&setup, LedgerObjectId, signers)
+			require.NoError(t, err)
+
+			for _, other := range ledgerObjects {
+				if other.name == object.name {
+					continue
+				}
+
+				err = other.create(&setup, LedgerObjectId, signers)
+				require.ErrorIs(t, err, types.ErrIdInUse, other.name)
+			}
+
+			// DIDs can't take the id of a ledger object either
+			pubKey, privKey, _ := ed25519.GenerateKey(rand.Reader)
+			_, err = setup.SendCreateDid(setup.CreateDid(pubKey, LedgerObjectId), map[string]ed25519.PrivateKey{LedgerObjectId + "#key-1": privKey})
+			require.ErrorIs(t, err, types.ErrIdInUse)
+		})
+	}
+}
+
+func TestCreateLedgerObjectDeactivatedController(t *testing.T) {
+	for _, object := range ledgerObjects {
+		t.Run(object.name, func(t *testing.T) {
+			setup := Setup()
+
+			signers, err := setup.InitCredDef()
+			require.NoError(t, err)
+
+			_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+			require.NoError(t, err)
+
+			err = object.create(&setup, LedgerObjectId, signers)
+			require.ErrorIs(t, err, types.ErrDidDocDeactivated)
+		})
+	}
+}
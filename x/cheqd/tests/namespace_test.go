@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	NamespaceTest    = "test"
+	NamespaceMainnet = "mainnet"
+)
+
+func (s *TestSetup) SubmitSetNamespacesProposal(namespaces ...types.Namespace) error {
+	var list []*types.Namespace
+	for i := range namespaces {
+		list = append(list, &namespaces[i])
+	}
+
+	_, err := s.SubmitProposal(types.NewSetNamespacesProposal("title", "description", list))
+	return err
+}
+
+func TestNamespaceStatusOnCreate(t *testing.T) {
+	cases := []struct {
+		name   string
+		status types.NamespaceStatus
+		valid  bool
+	}{
+		{"Valid: Active namespace", types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE, true},
+		{"Not Valid: Read-only namespace", types.NamespaceStatus_NAMESPACE_STATUS_READ_ONLY, false},
+		{"Not Valid: Retired namespace", types.NamespaceStatus_NAMESPACE_STATUS_RETIRED, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceTest, tc.status)))
+
+			_, _, err := setup.InitDid(AliceDID)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrNamespaceValidation)
+				require.Equal(t, "namespace test is not active: DID namespace validation failed", err.Error())
+			}
+		})
+	}
+}
+
+func TestNamespaceReadOnlyOnUpdate(t *testing.T) {
+	setup := Setup()
+	keys, msg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_READ_ONLY)))
+
+	// DIDs of read-only namespaces can still be resolved
+	_, err = setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	updateMsg := setup.CreateToUpdateDid(msg)
+	updateMsg.AlsoKnownAs = []string{"did:example:alice"}
+
+	_, err = setup.SendUpdateDid(updateMsg, MapToListOfSignerKeys(keys))
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+}
+
+func TestNamespaceUnregistered(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid("did:cheqd:mainnet:aaaaaaaaaaaaaaaa")
+	require.Error(t, err)
+
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceMainnet, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)))
+
+	_, _, err = setup.InitDid("did:cheqd:mainnet:aaaaaaaaaaaaaaaa")
+	require.NoError(t, err)
+}
+
+func TestNamespaceRetiredCantBeReactivated(t *testing.T) {
+	setup := Setup()
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_RETIRED)))
+
+	err := setup.SubmitSetNamespacesProposal(
+		types.NewNamespace(NamespaceMainnet, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE),
+		types.NewNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE),
+	)
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	require.Equal(t, "namespace test is retired and can't be changed to NAMESPACE_STATUS_ACTIVE: DID namespace validation failed", err.Error())
+
+	// The proposal is applied atomically
+	_, found := setup.Keeper.GetNamespace(setup.Ctx, NamespaceMainnet)
+	require.False(t, found)
+}
+
+func TestNamespaceLegacyFallback(t *testing.T) {
+	setup := Setup()
+
+	// Simulate a network upgraded from a version with a single namespace
+	setup.Ctx.KVStore(setup.StoreKey).Delete(types.KeyPrefix(types.NamespaceKey + NamespaceTest))
+	setup.Keeper.SetToState(setup.Ctx, types.DidNamespaceKey, []byte(NamespaceTest))
+
+	require.Equal(t, []types.Namespace{types.NewNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)}, setup.Keeper.GetAllNamespaces(setup.Ctx))
+
+	namespace, found := setup.Keeper.GetNamespace(setup.Ctx, NamespaceTest)
+	require.True(t, found)
+	require.True(t, namespace.IsActive())
+
+	_, found = setup.Keeper.GetNamespace(setup.Ctx, NamespaceMainnet)
+	require.False(t, found)
+
+	_, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// The first proposal moves the legacy namespace to the registry
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceMainnet, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)))
+	require.Len(t, setup.Keeper.GetAllNamespaces(setup.Ctx), 2)
+
+	_, found = setup.Keeper.GetNamespace(setup.Ctx, NamespaceTest)
+	require.True(t, found)
+}
+
+func TestNamespacesQuery(t *testing.T) {
+	setup := Setup()
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewNamespace(NamespaceMainnet, types.NamespaceStatus_NAMESPACE_STATUS_READ_ONLY)))
+
+	resp, err := setup.Keeper.Namespaces(sdk.WrapSDKContext(setup.Ctx), &types.QueryNamespacesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Namespace{
+		{Name: NamespaceMainnet, Status: types.NamespaceStatus_NAMESPACE_STATUS_READ_ONLY},
+		{Name: NamespaceTest, Status: types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE},
+	}, resp.Namespaces)
+
+	_, err = setup.Keeper.Namespaces(context.Background(), nil)
+	require.Error(t, err)
+}
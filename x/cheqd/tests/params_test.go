@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsLimitsOnCreate(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		name   string
+		update func(params *types.Params)
+		errMsg string
+	}{
+		{
+			valid:  true,
+			name:   "Valid: Default params",
+			update: func(params *types.Params) {},
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Too many verification methods",
+			update: func(params *types.Params) { params.MaxVerificationMethods = 1 },
+			errMsg: "number of verification methods 2 exceeds the limit of 1: module params validation failed",
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Too many services",
+			update: func(params *types.Params) { params.MaxServices = 1 },
+			errMsg: "number of services 2 exceeds the limit of 1: module params validation failed",
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Too many controllers",
+			update: func(params *types.Params) { params.MaxControllers = 1 },
+			errMsg: "number of controllers 2 exceeds the limit of 1: module params validation failed",
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Payload is too big",
+			update: func(params *types.Params) { params.MaxPayloadBytes = 16 },
+			errMsg: "payload size %d exceeds the limit of 16 bytes: module params validation failed",
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Verification method type is not allowed",
+			update: func(params *types.Params) { params.AllowedMethodTypes = []string{types.JsonWebKey2020} },
+			errMsg: fmt.Sprintf("%s: verification method type Ed25519VerificationKey2020 is not allowed: module params validation failed", EveKey1),
+		},
+		{
+			valid:  false,
+			name:   "Not Valid: Service type is not allowed",
+			update: func(params *types.Params) { params.AllowedServiceTypes = []string{"LinkedDomains"} },
+			errMsg: fmt.Sprintf("%s#service-2: service type DIDCommMessaging is not allowed: module params validation failed", EveDID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			keys := GenerateTestKeys()
+			keys[EveKey1] = GenerateKeyPair()
+			require.NoError(t, setup.CreateTestDIDs(keys))
+			setup.UpdateParams(tc.update)
+
+			msg := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+			msg.Controller = []string{AliceDID, EveDID}
+			msg.VerificationMethod = append(msg.VerificationMethod, &types.VerificationMethod{
+				Id:                 EveDID + "#key-2",
+				Type:               types.Ed25519VerificationKey2020,
+				Controller:         EveDID,
+				PublicKeyMultibase: msg.VerificationMethod[0].PublicKeyMultibase,
+			})
+			msg.Service = append(msg.Service, &types.Service{
+				Id:              EveDID + "#service-3",
+				Type:            "LinkedDomains",
+				ServiceEndpoint: "https://example.com",
+			})
+
+			signerKeys := map[string]ed25519.PrivateKey{
+				AliceKey1: keys[AliceKey1].PrivateKey,
+				EveKey1:   keys[EveKey1].PrivateKey,
+			}
+
+			did, err := setup.SendCreateDid(msg, signerKeys)
+
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, EveDID, did.Id)
+			} else {
+				require.Error(t, err)
+				errMsg := tc.errMsg
+				if strings.Contains(errMsg, "%d") {
+					errMsg = fmt.Sprintf(errMsg, len(msg.GetSignBytes()))
+				}
+				require.Equal(t, errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestParamsAllowNewServiceType(t *testing.T) {
+	keys := GenerateTestKeys()
+	setup := Setup()
+	require.NoError(t, setup.CreateTestDIDs(keys))
+
+	eveKeys := GenerateKeyPair()
+	msg := setup.CreateDid(eveKeys.PublicKey, EveDID)
+	msg.Service[0].Type = "CredentialRegistry"
+	signerKeys := map[string]ed25519.PrivateKey{EveKey1: eveKeys.PrivateKey}
+
+	_, err := setup.SendCreateDid(msg, signerKeys)
+	require.ErrorIs(t, err, types.ErrParamsValidation)
+
+	// Governance can allow service types the module doesn't know about
+	params := setup.Keeper.GetParams(setup.Ctx)
+	params.AllowedServiceTypes = append(params.AllowedServiceTypes, "CredentialRegistry")
+	require.NoError(t, params.Validate())
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	did, err := setup.SendCreateDid(msg, signerKeys)
+	require.NoError(t, err)
+	require.Equal(t, "CredentialRegistry", did.Service[0].Type)
+}
+
+func TestParamsLimitsOnPatch(t *testing.T) {
+	keys := GenerateTestKeys()
+	setup := Setup()
+	require.NoError(t, setup.CreateTestDIDs(keys))
+	setup.UpdateParams(func(params *types.Params) { params.MaxServices = 1 })
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	alice, err := state.UnpackDataAsDid()
+	require.NoError(t, err)
+
+	operations := []*types.DidPatchOperation{}
+	for i := len(alice.Service); i < 2; i++ {
+		operations = append(operations, &types.DidPatchOperation{
+			Op:    types.PatchOpAdd,
+			Field: types.PatchFieldService,
+			Service: &types.Service{
+				Id:              fmt.Sprintf("%s#service-%d", AliceDID, 10+i),
+				Type:            "LinkedDomains",
+				ServiceEndpoint: "https://example.com",
+			},
+		})
+	}
+
+	_, err = setup.SendPatchDid(
+		&types.MsgPatchDidPayload{Id: AliceDID, Operations: operations},
+		[]SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}},
+	)
+	require.Error(t, err)
+	require.Equal(t, "number of services 2 exceeds the limit of 1: module params validation failed", err.Error())
+}
+
+func TestParamsQuery(t *testing.T) {
+	setup := Setup()
+	setup.UpdateParams(func(params *types.Params) { params.MaxServices = 3 })
+
+	resp, err := setup.Keeper.Params(sdk.WrapSDKContext(setup.Ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+
+	expected := types.DefaultParams()
+	expected.MaxServices = 3
+	require.Equal(t, &expected, resp.Params)
+}
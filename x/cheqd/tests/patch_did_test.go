@@ -0,0 +1,245 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchDid(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[AliceKey2] = GenerateKeyPair()
+
+	cases := []struct {
+		valid      bool
+		name       string
+		signerKeys []SignerKey
+		msg        *types.MsgPatchDidPayload
+		check      func(t *testing.T, did *types.Did)
+		errMsg     string
+	}{
+		{
+			valid:      true,
+			name:       "Valid: Adding a service works",
+			signerKeys: []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{
+						Op:    types.PatchOpAdd,
+						Field: types.PatchFieldService,
+						Service: &types.Service{
+							Id:              AliceDID + "#service-1",
+							Type:            "LinkedDomains",
+							ServiceEndpoint: "https://example.com",
+						},
+					},
+				},
+			},
+			check: func(t *testing.T, did *types.Did) {
+				require.Equal(t, 1, len(did.Service))
+				require.Equal(t, AliceDID+"#service-1", did.Service[0].Id)
+				require.Equal(t, []string{AliceKey1}, did.Authentication)
+			},
+		},
+		{
+			valid: true,
+			name:  "Valid: Adding a verification method and a relationship works",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{
+						Op:    types.PatchOpAdd,
+						Field: types.PatchFieldVerificationMethod,
+						VerificationMethod: &types.VerificationMethod{
+							Id:                 AliceKey2,
+							Type:               Ed25519VerificationKey2020,
+							Controller:         AliceDID,
+							PublicKeyMultibase: "z" + base58.Encode(keys[AliceKey2].PublicKey),
+						},
+					},
+					{
+						Op:    types.PatchOpAdd,
+						Field: types.PatchFieldAssertionMethod,
+						Value: AliceKey2,
+					},
+				},
+			},
+			check: func(t *testing.T, did *types.Did) {
+				require.Equal(t, 2, len(did.VerificationMethod))
+				require.Equal(t, AliceKey2, did.VerificationMethod[1].Id)
+				require.Equal(t, []string{AliceKey2}, did.AssertionMethod)
+			},
+		},
+		{
+			valid: true,
+			name:  "Valid: Adding a controller works with signatures of both controllers",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+				{signer: BobKey2, key: keys[BobKey2].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpAdd, Field: types.PatchFieldController, Value: BobDID},
+				},
+			},
+			check: func(t *testing.T, did *types.Did) {
+				require.Equal(t, []string{BobDID}, did.Controller)
+			},
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Adding a controller requires the new controller signature",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpAdd, Field: types.PatchFieldController, Value: BobDID},
+				},
+			},
+			errMsg: fmt.Sprintf("there should be at least one signature by %s: signature is required but not found", BobDID),
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Removing a missing entry fails",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpRemove, Field: types.PatchFieldAlsoKnownAs, Value: "https://example.com"},
+				},
+			},
+			errMsg: "operation 0: alsoKnownAs entry not found: https://example.com: invalid DID patch operation",
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Patched DID is validated",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: AliceDID,
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpAdd, Field: types.PatchFieldAuthentication, Value: AliceKey1},
+				},
+			},
+			errMsg: "authentication: there should be no duplicates.: DID namespace validation failed",
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Wrong version id",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id:        AliceDID,
+				VersionId: "wrong-version",
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpAdd, Field: types.PatchFieldAlsoKnownAs, Value: "https://example.com"},
+				},
+			},
+			errMsg: "got: wrong-version, must be: %s: unexpected DID version",
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Patching not existing DID",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			msg: &types.MsgPatchDidPayload{
+				Id: NotFounDID,
+				Operations: []*types.DidPatchOperation{
+					{Op: types.PatchOpAdd, Field: types.PatchFieldAlsoKnownAs, Value: "https://example.com"},
+				},
+			},
+			errMsg: fmt.Sprintf("%s: DID Doc not found", NotFounDID),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := InitEnv(t, keys)
+			wrongVersion := tc.msg.VersionId != ""
+
+			did, err := setup.SendPatchDid(tc.msg, tc.signerKeys)
+
+			if tc.valid {
+				require.NoError(t, err)
+				tc.check(t, did)
+			} else {
+				require.Error(t, err)
+				errMsg := tc.errMsg
+				if wrongVersion {
+					state, _ := setup.Keeper.GetDid(&setup.Ctx, tc.msg.Id)
+					errMsg = fmt.Sprintf(errMsg, state.Metadata.VersionId)
+				}
+				require.Equal(t, errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestPatchDidReplay(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[AliceKey2] = GenerateKeyPair()
+	setup := InitEnv(t, keys)
+	signerKeys := []SignerKey{{signer: AliceKey1, key: keys[AliceKey1].PrivateKey}}
+
+	addKey := &types.MsgPatchDidPayload{
+		Id: AliceDID,
+		Operations: []*types.DidPatchOperation{
+			{
+				Op:    types.PatchOpAdd,
+				Field: types.PatchFieldVerificationMethod,
+				VerificationMethod: &types.VerificationMethod{
+					Id:                 AliceKey2,
+					Type:               Ed25519VerificationKey2020,
+					Controller:         AliceDID,
+					PublicKeyMultibase: "z" + base58.Encode(keys[AliceKey2].PublicKey),
+				},
+			},
+		},
+	}
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	addKey.VersionId = state.Metadata.VersionId
+
+	// Versions are identified by the hash of the tx
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("add key tx"))
+	signedAddKey := setup.WrapPatchRequest(addKey, signerKeys)
+	_, err = setup.Handler(setup.Ctx, signedAddKey)
+	require.NoError(t, err)
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("remove key tx"))
+	did, err := setup.SendPatchDid(&types.MsgPatchDidPayload{
+		Id: AliceDID,
+		Operations: []*types.DidPatchOperation{
+			{Op: types.PatchOpRemove, Field: types.PatchFieldVerificationMethod, Value: AliceKey2},
+		},
+	}, signerKeys)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(did.VerificationMethod))
+
+	// The removed key can't be added back by the replay of the signed patch
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("replay tx"))
+	_, err = setup.Handler(setup.Ctx, signedAddKey)
+	require.ErrorIs(t, err, types.ErrUnexpectedDidVersion)
+
+	// Patches without version id are rejected
+	addKey.VersionId = ""
+	_, err = setup.Handler(setup.Ctx, setup.WrapPatchRequest(addKey, signerKeys))
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	require.Contains(t, err.Error(), "version_id: cannot be blank")
+}
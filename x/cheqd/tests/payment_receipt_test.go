@@ -0,0 +1,311 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/ante"
+	"github.com/cheqd/cheqd-node/x/cheqd/keeper"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+const PaymentRequestId = "0a2bc4a6-1f45-4ff0-a046-703c71ab845d"
+
+var PayeeAddr = sdk.AccAddress([]byte("payee_______________"))
+
+func NewTestTxConfig() client.TxConfig {
+	ir := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(ir)
+	return authtx.NewTxConfig(codec.NewProtoCodec(ir), authtx.DefaultSignModes)
+}
+
+func EncodeSendTx(t *testing.T, txConfig client.TxConfig, memo string, msgs ...sdk.Msg) []byte {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetMemo(memo)
+
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+// PaymentTxContext returns the context bank messages of the transaction are executed in, after the ante handler has read the memo
+func PaymentTxContext(t *testing.T, ctx sdk.Context, txConfig client.TxConfig, memo string, msgs ...sdk.Msg) sdk.Context {
+	txBytes := EncodeSendTx(t, txConfig, memo, msgs...)
+
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	terminator := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	ctx, err = ante.NewPaymentMemoDecorator().AnteHandle(ctx.WithTxBytes(txBytes), tx, false, terminator)
+	require.NoError(t, err)
+
+	return ctx
+}
+
+// FundAccount mints coins to the account with the sdk bank keeper
+func FundAccount(t *testing.T, setup TestSetup, addr sdk.AccAddress, coins sdk.Coins) {
+	require.NoError(t, setup.SdkBankKeeper.MintCoins(setup.Ctx, minttypes.ModuleName, coins))
+	require.NoError(t, setup.SdkBankKeeper.SendCoinsFromModuleToAccount(setup.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+func NewTestPaymentMsgServer(setup TestSetup) banktypes.MsgServer {
+	return keeper.NewPaymentMsgServer(bankkeeper.NewMsgServerImpl(setup.SdkBankKeeper), setup.Keeper)
+}
+
+func TestPaymentReceipt(t *testing.T) {
+	setup := Setup()
+	txConfig := NewTestTxConfig()
+	msgServer := NewTestPaymentMsgServer(setup)
+
+	FundAccount(t, setup, HolderAddr, sdk.NewCoins(Payment(1000)))
+
+	send := func(amount int64, to sdk.AccAddress) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(HolderAddr, to, sdk.NewCoins(Payment(amount)))
+	}
+
+	cases := []struct {
+		name     string
+		memo     string
+		msgs     []*banktypes.MsgSend
+		receipts int
+		err      bool
+	}{
+		{
+			name:     "Valid: Send with the request id in the memo is indexed",
+			memo:     PaymentRequestId,
+			msgs:     []*banktypes.MsgSend{send(100, PayeeAddr)},
+			receipts: 1,
+		},
+		{
+			name:     "Valid: Uppercase request id is normalized",
+			memo:     "0A2BC4A6-1F45-4FF0-A046-703C71AB845D",
+			msgs:     []*banktypes.MsgSend{send(100, PayeeAddr)},
+			receipts: 2,
+		},
+		{
+			name:     "Valid: Several sends in one transaction are indexed separately",
+			memo:     PaymentRequestId,
+			msgs:     []*banktypes.MsgSend{send(100, PayeeAddr), send(50, RecipientAddr)},
+			receipts: 4,
+		},
+		{
+			name:     "Valid: Send with another memo isn't indexed",
+			memo:     "exchange deposit 12345",
+			msgs:     []*banktypes.MsgSend{send(100, PayeeAddr)},
+			receipts: 4,
+		},
+		{
+			name:     "Not Valid: Failed send isn't indexed",
+			memo:     PaymentRequestId,
+			msgs:     []*banktypes.MsgSend{send(10000, PayeeAddr)},
+			receipts: 4,
+			err:      true,
+		},
+	}
+
+	for i, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var msgs []sdk.Msg
+			for _, msg := range tc.msgs {
+				msgs = append(msgs, msg)
+			}
+
+			ctx := PaymentTxContext(t, setup.Ctx.WithBlockHeight(int64(i+1)), txConfig, tc.memo, msgs...)
+
+			for _, msg := range tc.msgs {
+				_, err := msgServer.Send(sdk.WrapSDKContext(ctx), msg)
+				if tc.err {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+
+			resp, err := setup.Keeper.PaymentReceipt(sdk.WrapSDKContext(ctx), &types.QueryPaymentReceiptRequest{RequestId: PaymentRequestId})
+			require.NoError(t, err)
+			require.Len(t, resp.Receipts, tc.receipts)
+		})
+	}
+
+	resp, err := setup.Keeper.PaymentReceipt(sdk.WrapSDKContext(setup.Ctx), &types.QueryPaymentReceiptRequest{RequestId: PaymentRequestId})
+	require.NoError(t, err)
+
+	// Receipts are ordered by height
+	first := resp.Receipts[0]
+	require.Equal(t, PaymentRequestId, first.RequestId)
+	require.Equal(t, HolderAddr.String(), first.Payer)
+	require.Equal(t, PayeeAddr.String(), first.Payee)
+	require.Equal(t, sdk.NewCoins(Payment(100)), first.GetCoins())
+	require.Equal(t, int64(1), first.Height)
+
+	// The second send of the transaction is sent to someone else
+	last := resp.Receipts[3]
+	require.Equal(t, RecipientAddr.String(), last.Payee)
+	require.Equal(t, int64(3), last.Height)
+	require.Equal(t, resp.Receipts[2].TxHash, last.TxHash)
+	require.Equal(t, utils.GetTxHash(EncodeSendTx(t, txConfig, PaymentRequestId, send(100, PayeeAddr), send(50, RecipientAddr))), last.TxHash)
+
+	// Funds are moved by the bank keeper
+	require.Equal(t, Payment(400), setup.SdkBankKeeper.GetBalance(setup.Ctx, PayeeAddr, types.BaseDenom))
+	require.Equal(t, Payment(550), setup.SdkBankKeeper.GetBalance(setup.Ctx, HolderAddr, types.BaseDenom))
+
+	_, err = setup.Keeper.PaymentReceipt(sdk.WrapSDKContext(setup.Ctx), &types.QueryPaymentReceiptRequest{RequestId: "not-a-uuid"})
+	require.Error(t, err)
+}
+
+func TestPaymentReceiptGas(t *testing.T) {
+	setup := Setup()
+	txConfig := NewTestTxConfig()
+	msgServer := NewTestPaymentMsgServer(setup)
+
+	FundAccount(t, setup, HolderAddr, sdk.NewCoins(Payment(1000)))
+
+	msg := banktypes.NewMsgSend(HolderAddr, PayeeAddr, sdk.NewCoins(Payment(1)))
+
+	consumedGas := func(memo string) uint64 {
+		ctx := PaymentTxContext(t, setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), txConfig, memo, msg)
+
+		_, err := msgServer.Send(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	withoutReceipt := consumedGas("exchange deposit 12345")
+	withReceipt := consumedGas(PaymentRequestId)
+
+	receipts := setup.Keeper.GetPaymentReceipts(setup.Ctx, PaymentRequestId)
+	require.Len(t, receipts, 1)
+
+	receiptGas := uint64(receipts[0].Size()) * types.DefaultDocumentByteCost
+	require.GreaterOrEqual(t, withReceipt, withoutReceipt+receiptGas)
+}
+
+func TestPaymentReceiptLimit(t *testing.T) {
+	setup := Setup()
+	txConfig := NewTestTxConfig()
+	msgServer := NewTestPaymentMsgServer(setup)
+
+	FundAccount(t, setup, HolderAddr, sdk.NewCoins(Payment(1000)))
+	FundAccount(t, setup, AttackerAddr, sdk.NewCoins(Payment(1000)))
+
+	// The attacker fills up its own receipts for the request id
+	spam := banktypes.NewMsgSend(AttackerAddr, PayeeAddr, sdk.NewCoins(Payment(1)))
+	for i := 0; i < types.PaymentReceiptsPerPayerMax+5; i++ {
+		ctx := PaymentTxContext(t, setup.Ctx.WithBlockHeight(int64(i+1)), txConfig, PaymentRequestId, spam)
+
+		// Sends above the limit are executed, but not recorded
+		_, err := msgServer.Send(sdk.WrapSDKContext(ctx), spam)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, setup.Keeper.GetPaymentReceipts(setup.Ctx, PaymentRequestId), types.PaymentReceiptsPerPayerMax)
+	require.Equal(t, Payment(types.PaymentReceiptsPerPayerMax+5), setup.SdkBankKeeper.GetBalance(setup.Ctx, PayeeAddr, types.BaseDenom))
+
+	// The holder still gets a receipt
+	payment := banktypes.NewMsgSend(HolderAddr, PayeeAddr, sdk.NewCoins(Payment(100)))
+	ctx := PaymentTxContext(t, setup.Ctx.WithBlockHeight(100), txConfig, PaymentRequestId, payment)
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), payment)
+	require.NoError(t, err)
+
+	receipts := setup.Keeper.GetPaymentReceipts(setup.Ctx, PaymentRequestId)
+	require.Len(t, receipts, types.PaymentReceiptsPerPayerMax+1)
+	require.Equal(t, HolderAddr.String(), receipts[len(receipts)-1].Payer)
+}
+
+func TestPaymentReceiptMultiSend(t *testing.T) {
+	setup := Setup()
+	txConfig := NewTestTxConfig()
+	msgServer := NewTestPaymentMsgServer(setup)
+
+	FundAccount(t, setup, HolderAddr, sdk.NewCoins(Payment(1000)))
+	FundAccount(t, setup, AttackerAddr, sdk.NewCoins(Payment(1000)))
+
+	// A single payer pays several payees
+	singleInput := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(HolderAddr, sdk.NewCoins(Payment(150)))},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(PayeeAddr, sdk.NewCoins(Payment(100))),
+			banktypes.NewOutput(RecipientAddr, sdk.NewCoins(Payment(50))),
+		},
+	}
+
+	ctx := PaymentTxContext(t, setup.Ctx.WithBlockHeight(1), txConfig, PaymentRequestId, singleInput)
+	_, err := msgServer.MultiSend(sdk.WrapSDKContext(ctx), singleInput)
+	require.NoError(t, err)
+
+	receipts := setup.Keeper.GetPaymentReceipts(setup.Ctx, PaymentRequestId)
+	require.Len(t, receipts, 2)
+	require.Equal(t, HolderAddr.String(), receipts[0].Payer)
+	require.Equal(t, PayeeAddr.String(), receipts[0].Payee)
+	require.Equal(t, sdk.NewCoins(Payment(100)), receipts[0].GetCoins())
+	require.Equal(t, RecipientAddr.String(), receipts[1].Payee)
+
+	// Outputs of several payers can't be attributed, so they aren't recorded
+	severalInputs := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{
+			banktypes.NewInput(HolderAddr, sdk.NewCoins(Payment(10))),
+			banktypes.NewInput(AttackerAddr, sdk.NewCoins(Payment(10))),
+		},
+		Outputs: []banktypes.Output{banktypes.NewOutput(PayeeAddr, sdk.NewCoins(Payment(20)))},
+	}
+
+	ctx = PaymentTxContext(t, setup.Ctx.WithBlockHeight(2), txConfig, PaymentRequestId, severalInputs)
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), severalInputs)
+	require.NoError(t, err)
+
+	require.Len(t, setup.Keeper.GetPaymentReceipts(setup.Ctx, PaymentRequestId), 2)
+	require.Equal(t, Payment(120), setup.SdkBankKeeper.GetBalance(setup.Ctx, PayeeAddr, types.BaseDenom))
+}
+
+func TestPaymentReceiptPagination(t *testing.T) {
+	setup := Setup()
+	txConfig := NewTestTxConfig()
+	msgServer := NewTestPaymentMsgServer(setup)
+
+	// The per payer limit doesn't bound the receipts of many payers
+	payers := 3 * types.PaymentReceiptsPerPayerMax
+	for i := 0; i < payers; i++ {
+		payer := sdk.AccAddress([]byte(fmt.Sprintf("payer_%014d", i)))
+		FundAccount(t, setup, payer, sdk.NewCoins(Payment(1)))
+
+		msg := banktypes.NewMsgSend(payer, PayeeAddr, sdk.NewCoins(Payment(1)))
+		ctx := PaymentTxContext(t, setup.Ctx.WithBlockHeight(int64(i+1)), txConfig, PaymentRequestId, msg)
+		_, err := msgServer.Send(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+
+	limit := uint64(types.PaymentReceiptsPerPayerMax)
+	firstPage, err := setup.Keeper.PaymentReceipt(sdk.WrapSDKContext(setup.Ctx), &types.QueryPaymentReceiptRequest{
+		RequestId:  PaymentRequestId,
+		Pagination: &query.PageRequest{Limit: limit, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, firstPage.Receipts, int(limit))
+	require.Equal(t, uint64(payers), firstPage.Pagination.Total)
+	require.NotNil(t, firstPage.Pagination.NextKey)
+
+	secondPage, err := setup.Keeper.PaymentReceipt(sdk.WrapSDKContext(setup.Ctx), &types.QueryPaymentReceiptRequest{
+		RequestId:  PaymentRequestId,
+		Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: limit},
+	})
+	require.NoError(t, err)
+	require.Len(t, secondPage.Receipts, int(limit))
+
+	// Pages continue in height order
+	require.Equal(t, int64(limit), firstPage.Receipts[limit-1].Height)
+	require.Equal(t, int64(limit+1), secondPage.Receipts[0].Height)
+}
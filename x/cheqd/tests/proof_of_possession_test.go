@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofOfPossessionOnCreate(t *testing.T) {
+	cases := []struct {
+		valid   bool
+		name    string
+		enabled bool
+		signers []string
+		errMsg  string
+	}{
+		{
+			valid:   true,
+			name:    "Valid: Controller alone can publish a key when the proof is not required",
+			enabled: false,
+			signers: []string{AliceKey1},
+		},
+		{
+			valid:   true,
+			name:    "Valid: Published key signs the payload",
+			enabled: true,
+			signers: []string{AliceKey1, EveKey1},
+		},
+		{
+			valid:   false,
+			name:    "Not Valid: Published key doesn't sign the payload",
+			enabled: true,
+			signers: []string{AliceKey1},
+			errMsg:  fmt.Sprintf("proof of possession is required for verification method %s: signature is required but not found", EveKey1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			keys := GenerateTestKeys()
+			keys[EveKey1] = GenerateKeyPair()
+			require.NoError(t, setup.CreateTestDIDs(keys))
+			setup.UpdateParams(func(params *types.Params) { params.RequireProofOfPossession = tc.enabled })
+
+			msg := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+			msg.Controller = []string{AliceDID}
+			msg.VerificationMethod[0].Controller = AliceDID
+
+			signerKeys := map[string]ed25519.PrivateKey{}
+			for _, signer := range tc.signers {
+				signerKeys[signer] = keys[signer].PrivateKey
+			}
+
+			did, err := setup.SendCreateDid(msg, signerKeys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, EveDID, did.Id)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestProofOfPossessionOnUpdate(t *testing.T) {
+	keys := GenerateTestKeys()
+	keys[AliceKey2] = GenerateKeyPair()
+
+	newKey := &types.VerificationMethod{
+		Id:                 AliceKey2,
+		Type:               Ed25519VerificationKey2020,
+		Controller:         AliceDID,
+		PublicKeyMultibase: "z" + base58.Encode(keys[AliceKey2].PublicKey),
+	}
+
+	cases := []struct {
+		valid      bool
+		name       string
+		signerKeys []SignerKey
+		operations []*types.DidPatchOperation
+		errMsg     string
+	}{
+		{
+			valid: true,
+			name:  "Valid: Added key signs the payload",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+				{signer: AliceKey2, key: keys[AliceKey2].PrivateKey},
+			},
+			operations: []*types.DidPatchOperation{
+				{Op: types.PatchOpAdd, Field: types.PatchFieldVerificationMethod, VerificationMethod: newKey},
+				{Op: types.PatchOpAdd, Field: types.PatchFieldAuthentication, Value: AliceKey2},
+			},
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Added key doesn't sign the payload",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			operations: []*types.DidPatchOperation{
+				{Op: types.PatchOpAdd, Field: types.PatchFieldVerificationMethod, VerificationMethod: newKey},
+				{Op: types.PatchOpAdd, Field: types.PatchFieldAuthentication, Value: AliceKey2},
+			},
+			errMsg: fmt.Sprintf("proof of possession is required for verification method %s: signature is required but not found", AliceKey2),
+		},
+		{
+			valid: false,
+			name:  "Not Valid: Added key signature is not valid",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+				{signer: AliceKey2, key: keys[AliceKey1].PrivateKey},
+			},
+			operations: []*types.DidPatchOperation{
+				{Op: types.PatchOpAdd, Field: types.PatchFieldVerificationMethod, VerificationMethod: newKey},
+			},
+			errMsg: fmt.Sprintf("proof of possession, method id: %s: invalid signature detected", AliceKey2),
+		},
+		{
+			valid: true,
+			name:  "Valid: Key agreement keys don't require the proof",
+			signerKeys: []SignerKey{
+				{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+			},
+			operations: []*types.DidPatchOperation{
+				{Op: types.PatchOpAdd, Field: types.PatchFieldVerificationMethod, VerificationMethod: newKey},
+				{Op: types.PatchOpAdd, Field: types.PatchFieldKeyAgreement, Value: AliceKey2},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			require.NoError(t, setup.CreateTestDIDs(keys))
+			setup.UpdateParams(func(params *types.Params) { params.RequireProofOfPossession = true })
+
+			did, err := setup.SendPatchDid(&types.MsgPatchDidPayload{Id: AliceDID, Operations: tc.operations}, tc.signerKeys)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.Equal(t, 2, len(did.VerificationMethod))
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
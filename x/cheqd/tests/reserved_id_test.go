@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func MultibaseKey(pubKey ed25519.PublicKey) string {
+	return "z" + base58.Encode(pubKey)
+}
+
+func TestCreateReservedDid(t *testing.T) {
+	cases := []struct {
+		name       string
+		controller []string
+		creator    string
+		signers    []string
+		err        error
+	}{
+		{
+			name:    "Valid: Allowed creator key is the key of the DID",
+			creator: EveKey1,
+			signers: []string{EveKey1},
+		},
+		{
+			name:       "Valid: Allowed creator key is the key of a controller",
+			controller: []string{AliceDID, EveDID},
+			creator:    AliceKey1,
+			signers:    []string{EveKey1, AliceKey1},
+		},
+		{
+			name:       "Valid: Extra signature of the allowed creator key",
+			controller: []string{EveDID},
+			creator:    AliceKey1,
+			signers:    []string{EveKey1, AliceKey1},
+		},
+		{
+			name:       "Not Valid: Allowed creator key didn't sign",
+			controller: []string{AliceDID, EveDID},
+			creator:    BobKey1,
+			signers:    []string{EveKey1, AliceKey1},
+			err:        types.ErrDidReserved,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			keys := GenerateTestKeys()
+			keys[EveKey1] = GenerateKeyPair()
+			setup := InitEnv(t, keys)
+
+			reservedId := types.NewReservedId(EveDID, MultibaseKey(keys[tc.creator].PublicKey))
+			_, err := setup.SubmitProposal(types.NewSetReservedIdsProposal("title", "description", []*types.ReservedId{&reservedId}))
+			require.NoError(t, err)
+
+			msg := setup.CreateDid(keys[EveKey1].PublicKey, EveDID)
+			msg.Controller = tc.controller
+
+			signers := map[string]ed25519.PrivateKey{}
+			for _, signer := range tc.signers {
+				signers[signer] = keys[signer].PrivateKey
+			}
+
+			_, err = setup.SendCreateDid(msg, signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+
+				// The reservation is consumed
+				status, reservation := setup.Keeper.GetIdStatus(setup.Ctx, EveDID)
+				require.Equal(t, types.IdStatus_ID_STATUS_IN_USE, status)
+				require.Nil(t, reservation)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestCreateBlockedDid(t *testing.T) {
+	setup := Setup()
+
+	blockedId := types.NewBlockedId(EveDID)
+	_, err := setup.SubmitProposal(types.NewSetReservedIdsProposal("title", "description", []*types.ReservedId{&blockedId}))
+	require.NoError(t, err)
+
+	_, _, err = setup.InitDid(EveDID)
+	require.ErrorIs(t, err, types.ErrDidBlocked)
+
+	// Batches can't contain blocked DIDs either
+	keys := GenerateTestKeys()
+	msg := &types.MsgCreateDidBatchPayload{Dids: []*types.MsgCreateDidPayload{
+		setup.CreateDid(keys[AliceKey1].PublicKey, AliceDID),
+		setup.CreateDid(keys[BobKey1].PublicKey, EveDID),
+	}}
+
+	_, err = setup.SendCreateDidBatch(msg, []SignerKey{
+		{signer: AliceKey1, key: keys[AliceKey1].PrivateKey},
+		{signer: EveKey1, key: keys[BobKey1].PrivateKey},
+	})
+	require.ErrorIs(t, err, types.ErrDidBlocked)
+}
+
+func TestReservedIdsProposals(t *testing.T) {
+	setup := InitEnv(t, GenerateTestKeys())
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	resp, err := setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: EveDID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryIdStatusResponse{Status: types.IdStatus_ID_STATUS_AVAILABLE}, resp)
+
+	// Reserve
+	reservedId := types.NewReservedId(EveDID, MultibaseKey(GenerateKeyPair().PublicKey))
+	_, err = setup.SubmitProposal(types.NewSetReservedIdsProposal("title", "description", []*types.ReservedId{&reservedId}))
+	require.NoError(t, err)
+
+	resp, err = setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: EveDID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryIdStatusResponse{Status: types.IdStatus_ID_STATUS_RESERVED, ReservedId: &reservedId}, resp)
+
+	// Block instead
+	blockedId := types.NewBlockedId(EveDID)
+	_, err = setup.SubmitProposal(types.NewSetReservedIdsProposal("title", "description", []*types.ReservedId{&blockedId}))
+	require.NoError(t, err)
+
+	resp, err = setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: EveDID})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryIdStatusResponse{Status: types.IdStatus_ID_STATUS_BLOCKED, ReservedId: &blockedId}, resp)
+
+	// Release
+	_, err = setup.SubmitProposal(types.NewReleaseReservedIdsProposal("title", "description", []string{EveDID}))
+	require.NoError(t, err)
+
+	resp, err = setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: EveDID})
+	require.NoError(t, err)
+	require.Equal(t, types.IdStatus_ID_STATUS_AVAILABLE, resp.Status)
+
+	_, err = setup.SubmitProposal(types.NewReleaseReservedIdsProposal("title", "description", []string{EveDID}))
+	require.ErrorIs(t, err, types.ErrReservedIdNotFound)
+
+	// DIDs in use can't be reserved
+	resp, err = setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: AliceDID})
+	require.NoError(t, err)
+	require.Equal(t, types.IdStatus_ID_STATUS_IN_USE, resp.Status)
+
+	aliceBlockedId := types.NewBlockedId(AliceDID)
+	_, err = setup.SubmitProposal(types.NewSetReservedIdsProposal("title", "description", []*types.ReservedId{&aliceBlockedId}))
+	require.ErrorIs(t, err, types.ErrDidDocExists)
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateResource(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		payload *types.MsgCreateResourcePayload
+		signers []SignerKey
+		err     error
+	}{
+		{
+			name:    "Valid: DID controller publishes a resource",
+			payload: NewResourcePayload(AliceDID, ResourceId1, "Trust framework", "TrustFramework", `{"name": "Example"}`),
+			signers: MapToListOfSignerKeys(aliceKeys),
+		},
+		{
+			name:    "Not Valid: Id is already used in the collection",
+			payload: NewResourcePayload(AliceDID, ResourceId1, "Trust framework", "TrustFramework", `{"name": "Example"}`),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrResourceExists,
+		},
+		{
+			name:    "Valid: The same id can be used in another collection",
+			payload: NewResourcePayload(BobDID, ResourceId1, "Trust framework", "TrustFramework", `{"name": "Example"}`),
+			signers: MapToListOfSignerKeys(bobKeys),
+		},
+		{
+			name:    "Not Valid: Signed by someone else",
+			payload: NewResourcePayload(AliceDID, ResourceId2, "Logo", "Logo", "logo"),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrSignatureNotFound,
+		},
+		{
+			name:    "Not Valid: Collection DID doesn't exist",
+			payload: NewResourcePayload(CharlieDID, ResourceId2, "Logo", "Logo", "logo"),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrDidDocNotFound,
+		},
+		{
+			name:    "Not Valid: Id is not a UUID",
+			payload: NewResourcePayload(AliceDID, "logo", "Logo", "Logo", "logo"),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrNamespaceValidation,
+		},
+		{
+			name:    "Not Valid: No content",
+			payload: NewResourcePayload(AliceDID, ResourceId2, "Logo", "Logo", ""),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrNamespaceValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource, err := setup.SendCreateResource(tc.payload, tc.signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+
+				checksum := sha256.Sum256(tc.payload.Data)
+				require.Equal(t, hex.EncodeToString(checksum[:]), resource.Header.Checksum)
+				require.Equal(t, tc.payload.Data, resource.Data)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestResourceVersions(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	signers := MapToListOfSignerKeys(aliceKeys)
+
+	v1, err := setup.SendCreateResource(NewResourcePayload(AliceDID, ResourceId1, "Context", "JSONLDContext", `{"@context": {}}`), signers)
+	require.NoError(t, err)
+	require.Empty(t, v1.Header.PreviousVersionId)
+
+	// A resource with another type isn't a version
+	_, err = setup.SendCreateResource(NewResourcePayload(AliceDID, ResourceId2, "Context", "Logo", "logo"), signers)
+	require.NoError(t, err)
+
+	// Names and types aren't simply concatenated in the index
+	other, err := setup.SendCreateResource(NewResourcePayload(AliceDID, "5a0e3c1b-7d2f-4e8a-9b6c-1f3d5e7a9c2b", "ContextJSON", "LDContext", "{}"), signers)
+	require.NoError(t, err)
+	require.Empty(t, other.Header.PreviousVersionId)
+
+	v2, err := setup.SendCreateResource(NewResourcePayload(AliceDID, ResourceId3, "Context", "JSONLDContext", `{"@context": {"@version": 1.1}}`), signers)
+	require.NoError(t, err)
+	require.Equal(t, ResourceId1, v2.Header.PreviousVersionId)
+
+	v3, err := setup.SendCreateResource(NewResourcePayload(AliceDID, ResourceId4, "Context", "JSONLDContext", `{"@context": {"@version": 1.1, "@vocab": ""}}`), signers)
+	require.NoError(t, err)
+	require.Equal(t, ResourceId3, v3.Header.PreviousVersionId)
+
+	for id, next := range map[string]string{ResourceId1: ResourceId3, ResourceId2: "", ResourceId3: ResourceId4, ResourceId4: ""} {
+		stateValue, err := setup.Keeper.GetResource(setup.Ctx, AliceDID, id)
+		require.NoError(t, err)
+
+		resource, err := stateValue.UnpackDataAsResource()
+		require.NoError(t, err)
+		require.Equal(t, next, resource.Header.NextVersionId, id)
+	}
+
+	// Listed headers include the links to the next versions
+	list, err := setup.Keeper.CollectionResources(sdk.WrapSDKContext(setup.Ctx), &types.QueryCollectionResourcesRequest{CollectionId: AliceDID})
+	require.NoError(t, err)
+	require.Len(t, list.Resources, 5)
+
+	for _, header := range list.Resources {
+		if header.Id == ResourceId1 {
+			require.Equal(t, ResourceId3, header.NextVersionId)
+		}
+	}
+}
+
+func TestQueryResources(t *testing.T) {
+	setup := Setup()
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	signers := MapToListOfSignerKeys(aliceKeys)
+	for _, id := range []string{ResourceId1, ResourceId2, ResourceId3} {
+		_, err = setup.SendCreateResource(NewResourcePayload(AliceDID, id, "Logo "+id, "Logo", "logo "+id), signers)
+		require.NoError(t, err)
+	}
+
+	// By id
+	resp, err := setup.Keeper.Resource(ctx, &types.QueryGetResourceRequest{CollectionId: AliceDID, Id: strings.ToUpper(ResourceId2)})
+	require.NoError(t, err)
+	require.Equal(t, []byte("logo "+ResourceId2), resp.Resource.Data)
+	require.NotEmpty(t, resp.Metadata.Created)
+
+	_, err = setup.Keeper.Resource(ctx, &types.QueryGetResourceRequest{CollectionId: BobDID, Id: ResourceId2})
+	require.ErrorIs(t, err, types.ErrResourceNotFound)
+
+	// By collection
+	list, err := setup.Keeper.CollectionResources(ctx, &types.QueryCollectionResourcesRequest{
+		CollectionId: AliceDID,
+		Pagination:   &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Resources, 3)
+	require.Equal(t, uint64(3), list.Pagination.Total)
+
+	empty, err := setup.Keeper.CollectionResources(ctx, &types.QueryCollectionResourcesRequest{CollectionId: BobDID})
+	require.NoError(t, err)
+	require.Empty(t, empty.Resources)
+
+	// By DID URL
+	dereferenced, err := setup.Keeper.DereferenceResource(ctx, &types.QueryDereferenceResourceRequest{DidUrl: AliceDID + "/resources/" + ResourceId3})
+	require.NoError(t, err)
+	require.Equal(t, ResourceId3, dereferenced.Resource.Header.Id)
+
+	for _, didUrl := range []string{
+		AliceDID + "/resources/" + ResourceId3 + "#key-1",
+		AliceDID + "/files/" + ResourceId3,
+		AliceDID,
+	} {
+		_, err = setup.Keeper.DereferenceResource(ctx, &types.QueryDereferenceResourceRequest{DidUrl: didUrl})
+		require.Error(t, err, didUrl)
+	}
+}
@@ -0,0 +1,276 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRevocRegDef(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, err := setup.InitCredDef()
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name       string
+		id         string
+		credDefId  string
+		controller []string
+		signers    []SignerKey
+		err        error
+		errMsg     string
+	}{
+		{
+			name:       "Valid: Issuer of the cred def creates a revocation registry",
+			id:         "did:cheqd:test:1111111111111111",
+			credDefId:  CredDefId,
+			controller: []string{AliceDID},
+			signers:    aliceKeys,
+		},
+		{
+			name:       "Not Valid: Cred def doesn't exist",
+			id:         "did:cheqd:test:2222222222222222",
+			credDefId:  "did:cheqd:test:zzzzzzzzzzzzzzzz",
+			controller: []string{AliceDID},
+			signers:    aliceKeys,
+			err:        types.ErrCredDefNotFound,
+		},
+		{
+			name:       "Not Valid: Controller is not an issuer of the cred def",
+			id:         "did:cheqd:test:3333333333333333",
+			credDefId:  CredDefId,
+			controller: []string{BobDID},
+			signers:    MapToListOfSignerKeys(bobKeys),
+			err:        types.ErrBadRequest,
+			errMsg:     "did:cheqd:test:bbbbbbbbbbbbbbbb is not a controller of the credential definition did:cheqd:test:dddddddddddddddd: bad request",
+		},
+		{
+			name:       "Not Valid: Issuer didn't sign",
+			id:         "did:cheqd:test:4444444444444444",
+			credDefId:  CredDefId,
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(bobKeys),
+			err:        types.ErrSignatureNotFound,
+		},
+		{
+			name:       "Not Valid: Id is already used",
+			id:         "did:cheqd:test:1111111111111111",
+			credDefId:  CredDefId,
+			controller: []string{AliceDID},
+			signers:    aliceKeys,
+			err:        types.ErrRevocRegDefExists,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewRevocRegDefPayload(tc.id, tc.credDefId, tc.controller...)
+
+			revocRegDef, err := setup.SendCreateRevocRegDef(msg, tc.signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, msg.ToRevocRegDef(), *revocRegDef)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				if tc.errMsg != "" {
+					require.Equal(t, tc.errMsg, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateRevocRegDef(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, err := setup.InitRevocRegDef()
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	existing, err := setup.Keeper.GetRevocRegDef(setup.Ctx, RevocRegDefId)
+	require.NoError(t, err)
+
+	updatedValue := `{"maxCredNum": 10, "tailsHash": "4Xk...7Yh", "tailsLocation": "https://tails.example.com/4Xk...7Yh"}`
+
+	// Wrong version
+	_, err = setup.SendUpdateRevocRegDef(&types.MsgUpdateRevocRegDefPayload{
+		Id:        RevocRegDefId,
+		Value:     updatedValue,
+		VersionId: "outdated",
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrUnexpectedRevocRegVersion)
+
+	// Not a controller
+	_, err = setup.SendUpdateRevocRegDef(&types.MsgUpdateRevocRegDefPayload{
+		Id:        RevocRegDefId,
+		Value:     updatedValue,
+		VersionId: existing.Metadata.VersionId,
+	}, MapToListOfSignerKeys(bobKeys))
+	require.ErrorIs(t, err, types.ErrSignatureNotFound)
+
+	// Doesn't exist
+	_, err = setup.SendUpdateRevocRegDef(&types.MsgUpdateRevocRegDefPayload{
+		Id:        "did:cheqd:test:zzzzzzzzzzzzzzzz",
+		Value:     updatedValue,
+		VersionId: existing.Metadata.VersionId,
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrRevocRegDefNotFound)
+
+	// Valid
+	updated, err := setup.SendUpdateRevocRegDef(&types.MsgUpdateRevocRegDefPayload{
+		Id:        RevocRegDefId,
+		Value:     updatedValue,
+		VersionId: existing.Metadata.VersionId,
+	}, aliceKeys)
+	require.NoError(t, err)
+	require.Equal(t, updatedValue, updated.Value)
+	require.Equal(t, CredDefId, updated.CredDefId)
+	require.Equal(t, []string{AliceDID}, updated.Controller)
+
+	stateValue, err := setup.Keeper.GetRevocRegDef(setup.Ctx, RevocRegDefId)
+	require.NoError(t, err)
+	require.Equal(t, existing.Metadata.Created, stateValue.Metadata.Created)
+	require.NotEmpty(t, stateValue.Metadata.Updated)
+}
+
+func TestRevocRegEntries(t *testing.T) {
+	setup := Setup()
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	aliceKeys, err := setup.InitRevocRegDef()
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	start := setup.Ctx.BlockTime()
+
+	// No entries yet
+	_, err = setup.Keeper.RevocRegEntry(ctx, &types.QueryGetRevocRegEntryRequest{RevocRegDefId: RevocRegDefId})
+	require.ErrorIs(t, err, types.ErrRevocRegEntryNotFound)
+
+	_, err = setup.SendUpdateRevocRegEntry(&types.MsgUpdateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		PrevAccum:     "accum-0",
+		Accum:         "accum-1",
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrRevocRegEntryNotFound)
+
+	// First entry
+	_, err = setup.SendCreateRevocRegEntry(&types.MsgCreateRevocRegEntryPayload{
+		RevocRegDefId: "did:cheqd:test:zzzzzzzzzzzzzzzz",
+		Accum:         "accum-1",
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrRevocRegDefNotFound)
+
+	_, err = setup.SendCreateRevocRegEntry(&types.MsgCreateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		Accum:         "accum-1",
+	}, MapToListOfSignerKeys(bobKeys))
+	require.ErrorIs(t, err, types.ErrSignatureNotFound)
+
+	first, err := setup.SendCreateRevocRegEntry(&types.MsgCreateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		Accum:         "accum-1",
+		Issued:        []uint64{1, 2, 3},
+	}, aliceKeys)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first.SeqNo)
+	require.Equal(t, start.Unix(), first.Timestamp)
+	require.Empty(t, first.PrevAccum)
+
+	_, err = setup.SendCreateRevocRegEntry(&types.MsgCreateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		Accum:         "accum-1",
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrRevocRegEntryExists)
+
+	// Deltas
+	setup.Ctx = setup.Ctx.WithBlockTime(start.Add(time.Hour))
+
+	_, err = setup.SendUpdateRevocRegEntry(&types.MsgUpdateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		PrevAccum:     "accum-0",
+		Accum:         "accum-2",
+		Revoked:       []uint64{2},
+	}, aliceKeys)
+	require.ErrorIs(t, err, types.ErrUnexpectedRevocRegVersion)
+
+	second, err := setup.SendUpdateRevocRegEntry(&types.MsgUpdateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		PrevAccum:     "accum-1",
+		Accum:         "accum-2",
+		Revoked:       []uint64{2},
+	}, aliceKeys)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), second.SeqNo)
+
+	// Two deltas in the same block
+	setup.Ctx = setup.Ctx.WithBlockTime(start.Add(2 * time.Hour))
+
+	_, err = setup.SendUpdateRevocRegEntry(&types.MsgUpdateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		PrevAccum:     "accum-2",
+		Accum:         "accum-3",
+		Issued:        []uint64{2},
+	}, aliceKeys)
+	require.NoError(t, err)
+
+	fourth, err := setup.SendUpdateRevocRegEntry(&types.MsgUpdateRevocRegEntryPayload{
+		RevocRegDefId: RevocRegDefId,
+		PrevAccum:     "accum-3",
+		Accum:         "accum-4",
+		Revoked:       []uint64{3},
+	}, aliceKeys)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), fourth.SeqNo)
+
+	// Latest entry
+	latest, err := setup.Keeper.RevocRegEntry(ctx, &types.QueryGetRevocRegEntryRequest{RevocRegDefId: RevocRegDefId})
+	require.NoError(t, err)
+	require.Equal(t, fourth, latest.RevocRegEntry)
+	require.NotEmpty(t, latest.Metadata.Updated)
+
+	// Entry at time
+	cases := []struct {
+		name      string
+		timestamp time.Time
+		accum     string
+		err       error
+	}{
+		{"Before the first entry", start.Add(-time.Second), "", types.ErrRevocRegEntryNotFound},
+		{"At the first entry", start, "accum-1", nil},
+		{"Between entries", start.Add(30 * time.Minute), "accum-1", nil},
+		{"At the second entry", start.Add(time.Hour), "accum-2", nil},
+		{"Last entry of the block", start.Add(2 * time.Hour), "accum-4", nil},
+		{"After the last entry", start.Add(24 * time.Hour), "accum-4", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := setup.Keeper.RevocRegEntryAtTime(ctx, &types.QueryGetRevocRegEntryAtTimeRequest{
+				RevocRegDefId: RevocRegDefId,
+				Timestamp:     tc.timestamp.Unix(),
+			})
+
+			if tc.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.accum, resp.RevocRegEntry.Accum)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	require.Len(t, setup.Keeper.GetAllRevocRegEntryHistory(setup.Ctx), 4)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSchema(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name       string
+		id         string
+		controller []string
+		signers    []SignerKey
+		err        error
+		errMsg     string
+	}{
+		{
+			name:       "Valid: Signed by the controller",
+			id:         "did:cheqd:test:1111111111111111",
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+		},
+		{
+			name:       "Valid: Signed by all the controllers",
+			id:         "did:cheqd:test:2222222222222222",
+			controller: []string{AliceDID, BobDID},
+			signers:    append(MapToListOfSignerKeys(aliceKeys), MapToListOfSignerKeys(bobKeys)...),
+		},
+		{
+			name:       "Not Valid: One of the controllers didn't sign",
+			id:         "did:cheqd:test:3333333333333333",
+			controller: []string{AliceDID, BobDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+			err:        types.ErrSignatureNotFound,
+			errMsg:     "signer: did:cheqd:test:bbbbbbbbbbbbbbbb: signature is required but not found",
+		},
+		{
+			name:       "Not Valid: Signed by someone else",
+			id:         "did:cheqd:test:4444444444444444",
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(bobKeys),
+			err:        types.ErrSignatureNotFound,
+			errMsg:     "signer: did:cheqd:test:aaaaaaaaaaaaaaaa: signature is required but not found",
+		},
+		{
+			name:       "Not Valid: Controller doesn't exist",
+			id:         "did:cheqd:test:5555555555555555",
+			controller: []string{CharlieDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+			err:        types.ErrDidDocNotFound,
+			errMsg:     CharlieDID + ": DID Doc not found",
+		},
+		{
+			name:       "Not Valid: Namespace is not registered",
+			id:         "did:cheqd:mainnet:6666666666666666",
+			controller: []string{AliceDID},
+			signers:    MapToListOfSignerKeys(aliceKeys),
+			err:        types.ErrNamespaceValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewSchemaPayload(tc.id, tc.controller...)
+
+			schema, err := setup.SendCreateSchema(msg, tc.signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, msg.ToSchema(), *schema)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				if tc.errMsg != "" {
+					require.Equal(t, tc.errMsg, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestCreateSchemaIsImmutable(t *testing.T) {
+	setup := Setup()
+
+	keys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	msg := NewSchemaPayload(SchemaId, AliceDID)
+	_, err = setup.SendCreateSchema(msg, MapToListOfSignerKeys(keys))
+	require.NoError(t, err)
+
+	// Neither a duplicate nor an update can be published with the same id
+	for _, version := range []string{msg.Version, "2.0"} {
+		updated := NewSchemaPayload(SchemaId, AliceDID)
+		updated.Version = version
+
+		_, err = setup.SendCreateSchema(updated, MapToListOfSignerKeys(keys))
+		require.ErrorIs(t, err, types.ErrSchemaExists)
+	}
+
+	stateValue, err := setup.Keeper.GetSchema(setup.Ctx, SchemaId)
+	require.NoError(t, err)
+
+	schema, err := stateValue.UnpackDataAsSchema()
+	require.NoError(t, err)
+	require.Equal(t, "1.0", schema.Version)
+}
+
+func TestQuerySchema(t *testing.T) {
+	setup := Setup()
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	_, err := setup.Keeper.Schema(ctx, &types.QueryGetSchemaRequest{Id: SchemaId})
+	require.ErrorIs(t, err, types.ErrSchemaNotFound)
+
+	keys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	msg := NewSchemaPayload(SchemaId, AliceDID)
+	_, err = setup.SendCreateSchema(msg, MapToListOfSignerKeys(keys))
+	require.NoError(t, err)
+
+	resp, err := setup.Keeper.Schema(ctx, &types.QueryGetSchemaRequest{Id: SchemaId})
+	require.NoError(t, err)
+	require.Equal(t, msg.ToSchema(), *resp.Schema)
+	require.NotEmpty(t, resp.Metadata.Created)
+
+	// Schemas don't shadow DIDs with the same id
+	_, err = setup.Keeper.Did(ctx, &types.QueryGetDidRequest{Id: SchemaId})
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSelfCertifyingDid(t *testing.T) {
+	cases := []struct {
+		name            string
+		selfCertifying  bool
+		derivedId       bool
+		signWithInitKey bool
+		err             error
+	}{
+		{
+			name:            "Valid: Unique id is derived from the initial key",
+			selfCertifying:  true,
+			derivedId:       true,
+			signWithInitKey: true,
+		},
+		{
+			name:            "Not Valid: Unique id isn't derived from the initial key",
+			selfCertifying:  true,
+			derivedId:       false,
+			signWithInitKey: true,
+			err:             types.ErrInvalidSelfCertifyingId,
+		},
+		{
+			name:            "Not Valid: Initial key didn't sign",
+			selfCertifying:  true,
+			derivedId:       true,
+			signWithInitKey: false,
+			err:             types.ErrSignatureNotFound,
+		},
+		{
+			name:            "Valid: Namespace doesn't require self-certifying ids",
+			selfCertifying:  false,
+			derivedId:       false,
+			signWithInitKey: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+
+			// Alice is created before the namespace starts requiring self-certifying ids
+			aliceKeys, _, err := setup.InitDid(AliceDID)
+			require.NoError(t, err)
+
+			namespace := types.NewNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)
+			if tc.selfCertifying {
+				namespace = types.NewSelfCertifyingNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)
+			}
+			require.NoError(t, setup.SubmitSetNamespacesProposal(namespace))
+
+			initKey := GenerateKeyPair()
+
+			did := EveDID
+			if tc.derivedId {
+				uniqueId, err := utils.DeriveSelfCertifyingId(initKey.PublicKey)
+				require.NoError(t, err)
+				did = utils.JoinDID("cheqd", NamespaceTest, uniqueId)
+			}
+
+			msg := setup.CreateDid(initKey.PublicKey, did)
+			signers := map[string]ed25519.PrivateKey{did + "#key-1": initKey.PrivateKey}
+
+			if !tc.signWithInitKey {
+				// The DID is controlled by Alice only, so her signature is enough to pass the controller checks
+				msg.Controller = []string{AliceDID}
+				signers = aliceKeys
+			}
+
+			_, err = setup.SendCreateDid(msg, signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestSelfCertifyingIdSignatureIsVerifiedOnce(t *testing.T) {
+	const sigVerifyCost = 1000000
+
+	setup := Setup()
+	require.NoError(t, setup.SubmitSetNamespacesProposal(types.NewSelfCertifyingNamespace(NamespaceTest, types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE)))
+	setup.UpdateParams(func(params *types.Params) {
+		params.RequireProofOfPossession = false
+		params.GasParams.SigVerifyCostEd25519 = sigVerifyCost
+	})
+
+	initKey := GenerateKeyPair()
+	uniqueId, err := utils.DeriveSelfCertifyingId(initKey.PublicKey)
+	require.NoError(t, err)
+	did := utils.JoinDID("cheqd", NamespaceTest, uniqueId)
+
+	setup.Ctx = setup.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = setup.SendCreateDid(setup.CreateDid(initKey.PublicKey, did), map[string]ed25519.PrivateKey{did + "#key-1": initKey.PrivateKey})
+	require.NoError(t, err)
+
+	consumed := setup.Ctx.GasMeter().GasConsumed()
+	require.GreaterOrEqual(t, consumed, uint64(sigVerifyCost))
+	require.Less(t, consumed, uint64(2*sigVerifyCost))
+}
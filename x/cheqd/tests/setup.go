@@ -14,6 +14,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -30,10 +40,16 @@ type KeyPair struct {
 }
 
 type TestSetup struct {
-	Cdc     codec.Codec
-	Ctx     sdk.Context
-	Keeper  keeper.Keeper
-	Handler sdk.Handler
+	Cdc        codec.Codec
+	Ctx        sdk.Context
+	StoreKey   sdk.StoreKey
+	Keeper     keeper.Keeper
+	GovKeeper  *TestGovKeeper
+	BankKeeper *TestBankKeeper
+	Handler    sdk.Handler
+
+	// SdkBankKeeper is the bank keeper of the sdk, the module uses BankKeeper
+	SdkBankKeeper bankkeeper.BaseKeeper
 }
 
 type SignerKey struct {
@@ -45,6 +61,7 @@ func Setup() TestSetup {
 	// Init Codec
 	ir := codectypes.NewInterfaceRegistry()
 	types.RegisterInterfaces(ir)
+	authtypes.RegisterInterfaces(ir)
 	cdc := codec.NewProtoCodec(ir)
 
 	// Init KVSore
@@ -54,10 +71,26 @@ func Setup() TestSetup {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
 
+	paramsStoreKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	dbStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, nil)
+
+	authStoreKey := sdk.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := sdk.NewKVStoreKey(banktypes.StoreKey)
+	dbStore.MountStoreWithDB(authStoreKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(bankStoreKey, sdk.StoreTypeIAVL, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	newKeeper := keeper.NewKeeper(cdc, storeKey)
+	paramsKeeper := paramskeeper.NewKeeper(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey)
+	bankKeeper := NewTestBankKeeper()
+	newKeeper := keeper.NewKeeper(cdc, storeKey, paramsKeeper.Subspace(types.ModuleName), bankKeeper)
+
+	accountKeeper := authkeeper.NewAccountKeeper(cdc, authStoreKey, paramsKeeper.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount,
+		map[string][]string{minttypes.ModuleName: {authtypes.Minter}})
+	sdkBankKeeper := bankkeeper.NewBaseKeeper(cdc, bankStoreKey, accountKeeper, paramsKeeper.Subspace(banktypes.ModuleName), map[string]bool{})
 
 	// Create Tx
 	txBytes := make([]byte, 28)
@@ -72,13 +105,20 @@ func Setup() TestSetup {
 	handler := cheqd.NewHandler(*newKeeper)
 
 	setup := TestSetup{
-		Cdc:     cdc,
-		Ctx:     ctx,
-		Keeper:  *newKeeper,
-		Handler: handler,
+		Cdc:        cdc,
+		Ctx:        ctx,
+		StoreKey:   storeKey,
+		Keeper:     *newKeeper,
+		GovKeeper:  &TestGovKeeper{},
+		BankKeeper: bankKeeper,
+		Handler:    handler,
+
+		SdkBankKeeper: sdkBankKeeper,
 	}
 
-	setup.Keeper.SetDidNamespace(ctx, "test")
+	sdkBankKeeper.SetParams(ctx, banktypes.DefaultParams())
+
+	setup.Keeper.SetNamespace(ctx, types.NewNamespace("test", types.NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
 	return setup
 }
 
@@ -165,6 +205,304 @@ func (s *TestSetup) WrapUpdateRequest(payload *types.MsgUpdateDidPayload, keys [
 	}
 }
 
+func (s *TestSetup) WrapPatchRequest(payload *types.MsgPatchDidPayload, keys []SignerKey) *types.MsgPatchDid {
+	var signatures []*types.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for _, skey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(skey.key, signingInput))
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: skey.signer,
+			Signature:            signature,
+		})
+	}
+
+	return &types.MsgPatchDid{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (s *TestSetup) WrapCreateBatchRequest(payload *types.MsgCreateDidBatchPayload, keys []SignerKey) *types.MsgCreateDidBatch {
+	var signatures []*types.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for _, skey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(skey.key, signingInput))
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: skey.signer,
+			Signature:            signature,
+		})
+	}
+
+	return &types.MsgCreateDidBatch{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+// SignPayload signs the raw payload bytes with each of the keys
+func SignPayload(payload types.IdentityMsg, keys []SignerKey) []*types.SignInfo {
+	var signatures []*types.SignInfo
+	signingInput := payload.GetSignBytes()
+
+	for _, skey := range keys {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(skey.key, signingInput))
+		signatures = append(signatures, &types.SignInfo{
+			VerificationMethodId: skey.signer,
+			Signature:            signature,
+		})
+	}
+
+	return signatures
+}
+
+func (s *TestSetup) WrapCreateSchemaRequest(payload *types.MsgCreateSchemaPayload, keys []SignerKey) *types.MsgCreateSchema {
+	return types.NewMsgCreateSchema(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapCreateCredDefRequest(payload *types.MsgCreateCredDefPayload, keys []SignerKey) *types.MsgCreateCredDef {
+	return types.NewMsgCreateCredDef(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapCreateRevocRegDefRequest(payload *types.MsgCreateRevocRegDefPayload, keys []SignerKey) *types.MsgCreateRevocRegDef {
+	return types.NewMsgCreateRevocRegDef(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapUpdateRevocRegDefRequest(payload *types.MsgUpdateRevocRegDefPayload, keys []SignerKey) *types.MsgUpdateRevocRegDef {
+	return types.NewMsgUpdateRevocRegDef(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapCreateRevocRegEntryRequest(payload *types.MsgCreateRevocRegEntryPayload, keys []SignerKey) *types.MsgCreateRevocRegEntry {
+	return types.NewMsgCreateRevocRegEntry(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapUpdateRevocRegEntryRequest(payload *types.MsgUpdateRevocRegEntryPayload, keys []SignerKey) *types.MsgUpdateRevocRegEntry {
+	return types.NewMsgUpdateRevocRegEntry(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapCreateStatusListRequest(payload *types.MsgCreateStatusListPayload, keys []SignerKey) *types.MsgCreateStatusList {
+	return types.NewMsgCreateStatusList(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapUpdateStatusListRequest(payload *types.MsgUpdateStatusListPayload, keys []SignerKey) *types.MsgUpdateStatusList {
+	return types.NewMsgUpdateStatusList(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapCreateResourceRequest(payload *types.MsgCreateResourcePayload, keys []SignerKey) *types.MsgCreateResource {
+	return types.NewMsgCreateResource(payload, SignPayload(payload, keys))
+}
+
+func (s *TestSetup) WrapClaimPaymentRequest(payload *types.MsgClaimPaymentPayload, keys []SignerKey) *types.MsgClaimPayment {
+	return types.NewMsgClaimPayment(payload, SignPayload(payload, keys))
+}
+
+// Ledger objects
+
+func NewSchemaPayload(id string, controller ...string) *types.MsgCreateSchemaPayload {
+	return &types.MsgCreateSchemaPayload{
+		Id:         id,
+		Type:       types.SchemaTypeCL,
+		AttrNames:  []string{"name", "age"},
+		Name:       "Passport",
+		Version:    "1.0",
+		Controller: controller,
+	}
+}
+
+func NewCredDefPayload(id string, schemaId string, controller ...string) *types.MsgCreateCredDefPayload {
+	return &types.MsgCreateCredDefPayload{
+		Id:            id,
+		SchemaId:      schemaId,
+		SignatureType: types.CredDefSignatureTypeCL,
+		Tag:           "default",
+		Value:         CredDefValue,
+		Controller:    controller,
+	}
+}
+
+func NewRevocRegDefPayload(id string, credDefId string, controller ...string) *types.MsgCreateRevocRegDefPayload {
+	return &types.MsgCreateRevocRegDefPayload{
+		Id:         id,
+		Type:       types.RevocRegTypeCLAccum,
+		CredDefId:  credDefId,
+		Tag:        "default",
+		Value:      RevocRegDefValue,
+		Controller: controller,
+	}
+}
+
+func NewStatusListPayload(id string, owner string, size uint64) *types.MsgCreateStatusListPayload {
+	return &types.MsgCreateStatusListPayload{
+		Id:            id,
+		Owner:         owner,
+		StatusPurpose: types.StatusPurposeRevocation,
+		Size_:         size,
+	}
+}
+
+func NewResourcePayload(collectionId string, id string, name string, resourceType string, data string) *types.MsgCreateResourcePayload {
+	return &types.MsgCreateResourcePayload{
+		CollectionId: collectionId,
+		Id:           id,
+		Name:         name,
+		ResourceType: resourceType,
+		MediaType:    "application/json",
+		Data:         []byte(data),
+	}
+}
+
+// InitCredDef creates a schema and a cred def controlled by Alice
+func (s *TestSetup) InitCredDef() ([]SignerKey, error) {
+	keys, _, err := s.InitDid(AliceDID)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := MapToListOfSignerKeys(keys)
+
+	if _, err = s.SendCreateSchema(NewSchemaPayload(SchemaId, AliceDID), signers); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.SendCreateCredDef(NewCredDefPayload(CredDefId, SchemaId, AliceDID), signers); err != nil {
+		return nil, err
+	}
+
+	return signers, nil
+}
+
+// InitRevocRegDef creates a schema, a cred def and a revocation registry definition controlled by Alice
+func (s *TestSetup) InitRevocRegDef() ([]SignerKey, error) {
+	signers, err := s.InitCredDef()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.SendCreateRevocRegDef(NewRevocRegDefPayload(RevocRegDefId, CredDefId, AliceDID), signers); err != nil {
+		return nil, err
+	}
+
+	return signers, nil
+}
+
+// TestBankKeeper is a fake bank and distribution keeper tracking account and module balances
+type TestBankKeeper struct {
+	balances map[string]sdk.Coins
+	burnt    sdk.Coins
+}
+
+func NewTestBankKeeper() *TestBankKeeper {
+	return &TestBankKeeper{balances: map[string]sdk.Coins{}}
+}
+
+func (bk *TestBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (bk *TestBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return bk.send(senderModule, recipientModule, amt)
+}
+
+func (bk *TestBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return bk.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (bk *TestBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := bk.send(moduleName, "", amt); err != nil {
+		return err
+	}
+
+	bk.burnt = bk.burnt.Add(amt...)
+	return nil
+}
+
+func (bk *TestBankKeeper) FundCommunityPool(_ sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return bk.send(sender.String(), bk.GetModuleAddress(distrtypes.ModuleName).String(), amount)
+}
+
+func (bk *TestBankKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+func (bk *TestBankKeeper) send(from string, to string, amt sdk.Coins) error {
+	if isModuleName(from) {
+		from = bk.GetModuleAddress(from).String()
+	}
+
+	if isModuleName(to) {
+		to = bk.GetModuleAddress(to).String()
+	}
+
+	balance, hasNeg := bk.balances[from].SafeSub(amt)
+	if hasNeg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", bk.balances[from], amt)
+	}
+
+	bk.balances[from] = balance
+	bk.balances[to] = bk.balances[to].Add(amt...)
+
+	return nil
+}
+
+// GetBalance returns the balance of the account in the base denom
+func (bk *TestBankKeeper) GetBalance(addr sdk.AccAddress) int64 {
+	return bk.balances[addr.String()].AmountOf(types.BaseDenom).Int64()
+}
+
+func isModuleName(name string) bool {
+	return name == types.ModuleName || name == types.EscrowAccountName || name == authtypes.FeeCollectorName
+}
+
+// TestGovKeeper is a fake gov keeper holding proposals in the active queue
+type TestGovKeeper struct {
+	proposals []govtypes.Proposal
+}
+
+func (gk *TestGovKeeper) IterateActiveProposalsQueue(_ sdk.Context, endTime time.Time, cb func(proposal govtypes.Proposal) (stop bool)) {
+	for _, proposal := range gk.proposals {
+		if proposal.VotingEndTime.After(endTime) {
+			continue
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// SubmitProposal executes the proposal the same way as the gov module does when the proposal passes
+// and returns the id assigned to it
+func (s *TestSetup) SubmitProposal(content govtypes.Content) (uint64, error) {
+	if err := content.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	id := uint64(len(s.GovKeeper.proposals) + 1)
+	proposal, err := govtypes.NewProposal(content, id, s.Ctx.BlockTime(), s.Ctx.BlockTime())
+	if err != nil {
+		return 0, err
+	}
+
+	proposal.Status = govtypes.StatusVotingPeriod
+	proposal.VotingEndTime = s.Ctx.BlockTime()
+	s.GovKeeper.proposals = append(s.GovKeeper.proposals, proposal)
+
+	handler := cheqd.NewProposalHandler(s.Keeper, s.GovKeeper)
+	err = handler(s.Ctx, content)
+
+	// Processed proposals are removed from the active queue
+	s.GovKeeper.proposals[id-1].Status = govtypes.StatusPassed
+
+	return id, err
+}
+
+func (s *TestSetup) UpdateParams(update func(params *types.Params)) {
+	params := s.Keeper.GetParams(s.Ctx)
+	update(&params)
+	s.Keeper.SetParams(s.Ctx, params)
+}
+
 func GenerateKeyPair() KeyPair {
 	PublicKey, PrivateKey, _ := ed25519.GenerateKey(rand.Reader)
 	return KeyPair{PrivateKey, PublicKey}
@@ -208,6 +546,22 @@ func (s *TestSetup) SendUpdateDid(msg *types.MsgUpdateDidPayload, keys []SignerK
 	return updated.UnpackDataAsDid()
 }
 
+func (s *TestSetup) SendPatchDid(msg *types.MsgPatchDidPayload, keys []SignerKey) (*types.Did, error) {
+	// query Did
+	state, err := s.Keeper.GetDid(&s.Ctx, msg.Id)
+	if err == nil && len(msg.VersionId) == 0 {
+		msg.VersionId = state.Metadata.VersionId
+	}
+
+	_, err = s.Handler(s.Ctx, s.WrapPatchRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	patched, _ := s.Keeper.GetDid(&s.Ctx, msg.Id)
+	return patched.UnpackDataAsDid()
+}
+
 func (s *TestSetup) SendCreateDid(msg *types.MsgCreateDidPayload, keys map[string]ed25519.PrivateKey) (*types.Did, error) {
 	_, err := s.Handler(s.Ctx, s.WrapCreateRequest(msg, keys))
 	if err != nil {
@@ -218,6 +572,116 @@ func (s *TestSetup) SendCreateDid(msg *types.MsgCreateDidPayload, keys map[strin
 	return created.UnpackDataAsDid()
 }
 
+func (s *TestSetup) SendCreateSchema(msg *types.MsgCreateSchemaPayload, keys []SignerKey) (*types.Schema, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateSchemaRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetSchema(s.Ctx, msg.Id)
+	return created.UnpackDataAsSchema()
+}
+
+func (s *TestSetup) SendCreateCredDef(msg *types.MsgCreateCredDefPayload, keys []SignerKey) (*types.CredDef, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateCredDefRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	created, _ := s.Keeper.GetCredDef(s.Ctx, msg.Id)
+	return created.UnpackDataAsCredDef()
+}
+
+func (s *TestSetup) SendCreateRevocRegDef(msg *types.MsgCreateRevocRegDefPayload, keys []SignerKey) (*types.RevocRegDef, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateRevocRegDefRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetRevocRegDef(s.Ctx, msg.Id)
+	return stateValue.UnpackDataAsRevocRegDef()
+}
+
+func (s *TestSetup) SendUpdateRevocRegDef(msg *types.MsgUpdateRevocRegDefPayload, keys []SignerKey) (*types.RevocRegDef, error) {
+	_, err := s.Handler(s.Ctx, s.WrapUpdateRevocRegDefRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetRevocRegDef(s.Ctx, msg.Id)
+	return stateValue.UnpackDataAsRevocRegDef()
+}
+
+func (s *TestSetup) SendCreateRevocRegEntry(msg *types.MsgCreateRevocRegEntryPayload, keys []SignerKey) (*types.RevocRegEntry, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateRevocRegEntryRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetRevocRegEntry(s.Ctx, msg.RevocRegDefId)
+	return stateValue.UnpackDataAsRevocRegEntry()
+}
+
+func (s *TestSetup) SendUpdateRevocRegEntry(msg *types.MsgUpdateRevocRegEntryPayload, keys []SignerKey) (*types.RevocRegEntry, error) {
+	_, err := s.Handler(s.Ctx, s.WrapUpdateRevocRegEntryRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetRevocRegEntry(s.Ctx, msg.RevocRegDefId)
+	return stateValue.UnpackDataAsRevocRegEntry()
+}
+
+func (s *TestSetup) SendCreateStatusList(msg *types.MsgCreateStatusListPayload, keys []SignerKey) (*types.StatusList, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateStatusListRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetStatusList(s.Ctx, msg.Id)
+	return stateValue.UnpackDataAsStatusList()
+}
+
+func (s *TestSetup) SendUpdateStatusList(msg *types.MsgUpdateStatusListPayload, keys []SignerKey) (*types.StatusList, error) {
+	_, err := s.Handler(s.Ctx, s.WrapUpdateStatusListRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetStatusList(s.Ctx, msg.Id)
+	return stateValue.UnpackDataAsStatusList()
+}
+
+func (s *TestSetup) SendCreateResource(msg *types.MsgCreateResourcePayload, keys []SignerKey) (*types.Resource, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateResourceRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	stateValue, _ := s.Keeper.GetResource(s.Ctx, msg.CollectionId, msg.Id)
+	return stateValue.UnpackDataAsResource()
+}
+
+func (s *TestSetup) SendCreateDidBatch(msg *types.MsgCreateDidBatchPayload, keys []SignerKey) ([]*types.Did, error) {
+	_, err := s.Handler(s.Ctx, s.WrapCreateBatchRequest(msg, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*types.Did
+	for _, id := range msg.GetDidIds() {
+		state, _ := s.Keeper.GetDid(&s.Ctx, id)
+		did, err := state.UnpackDataAsDid()
+		if err != nil {
+			return nil, err
+		}
+
+		created = append(created, did)
+	}
+
+	return created, nil
+}
+
 func ConcatKeys(dst map[string]ed25519.PrivateKey, src map[string]ed25519.PrivateKey) map[string]ed25519.PrivateKey {
 	for k, v := range src {
 		dst[k] = v
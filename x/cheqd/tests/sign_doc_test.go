@@ -0,0 +1,314 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignDoc(t *testing.T) {
+	cases := []struct {
+		valid          bool
+		name           string
+		chainId        string
+		namespace      string
+		msgType        string
+		signDocVersion uint32
+		signMode       types.SignBytesMode
+		verifyMode     types.SignBytesMode
+		expiryHeight   uint64
+		legacyEnd      uint64
+		errMsg         string
+	}{
+		{
+			valid:          true,
+			name:           "Valid: Sign doc bound to the current network",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+		},
+		{
+			valid:          true,
+			name:           "Valid: Sign doc is not expired yet",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			expiryHeight:   10,
+		},
+		{
+			valid:          true,
+			name:           "Valid: JCS sign doc",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			signMode:       types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+			verifyMode:     types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Legacy sign doc in JCS mode",
+			signDocVersion: types.SignDocVersionLegacy,
+			verifyMode:     types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+			legacyEnd:      10,
+			errMsg:         "signatures: (0: (sign_doc_version: must be 1 in JCS mode.).).: DID namespace validation failed",
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Sign info declares another sign bytes mode",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			signMode:       types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+			verifyMode:     types.SignBytesMode_SIGN_BYTES_MODE_PROTO,
+			errMsg:         fmt.Sprintf("method id: %s: invalid signature detected", EveKey1),
+		},
+		{
+			valid:          true,
+			name:           "Valid: Legacy signature within the transition window",
+			signDocVersion: types.SignDocVersionLegacy,
+			legacyEnd:      10,
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Sign doc for another chain",
+			chainId:        "other-chain",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			errMsg:         fmt.Sprintf("method id: %s: invalid signature detected", EveKey1),
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Sign doc for another namespace",
+			chainId:        "test",
+			namespace:      "mainnet",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			errMsg:         fmt.Sprintf("method id: %s: invalid signature detected", EveKey1),
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Sign doc for another message type",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgUpdateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			errMsg:         fmt.Sprintf("method id: %s: invalid signature detected", EveKey1),
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Sign doc is expired",
+			chainId:        "test",
+			namespace:      "test",
+			msgType:        sdk.MsgTypeURL(&types.MsgCreateDid{}),
+			signDocVersion: types.SignDocVersion1,
+			expiryHeight:   9,
+			errMsg:         fmt.Sprintf("method id: %s, expiry height: 9: signature expired", EveKey1),
+		},
+		{
+			valid:          false,
+			name:           "Not Valid: Legacy signature after the transition window",
+			signDocVersion: types.SignDocVersionLegacy,
+			legacyEnd:      9,
+			errMsg:         fmt.Sprintf("method id: %s: legacy signatures are not accepted anymore", EveKey1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := Setup()
+			setup.Ctx = setup.Ctx.WithBlockHeight(10)
+			setup.UpdateParams(func(params *types.Params) { params.LegacySignaturesEndHeight = tc.legacyEnd })
+
+			keyPair := GenerateKeyPair()
+			payload := setup.CreateDid(keyPair.PublicKey, EveDID)
+
+			signPayload := types.NewSignPayloadForMode(tc.chainId, tc.namespace, tc.msgType, payload, tc.signMode)
+			signBytes, err := signPayload.GetSignBytes(tc.signDocVersion, tc.signMode, tc.expiryHeight)
+			require.NoError(t, err)
+
+			msg := &types.MsgCreateDid{
+				Payload: payload,
+				Signatures: []*types.SignInfo{
+					{
+						VerificationMethodId: EveKey1,
+						Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(keyPair.PrivateKey, signBytes)),
+						SignDocVersion:       tc.signDocVersion,
+						ExpiryHeight:         tc.expiryHeight,
+						SignBytesMode:        tc.verifyMode,
+					},
+				},
+			}
+
+			_, err = setup.Handler(setup.Ctx, msg)
+
+			if tc.valid {
+				require.Nil(t, err)
+				require.True(t, setup.Keeper.HasDid(&setup.Ctx, EveDID))
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestSignDocOnUpdate(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+	require.NoError(t, setup.CreateTestDIDs(keys))
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	did, err := state.UnpackDataAsDid()
+	require.NoError(t, err)
+
+	payload := &types.MsgUpdateDidPayload{
+		Id:                 AliceDID,
+		VerificationMethod: did.VerificationMethod,
+		Authentication:     did.Authentication,
+		AlsoKnownAs:        []string{"https://example.com"},
+		VersionId:          state.Metadata.VersionId,
+	}
+
+	signPayload := types.NewSignPayloadForMode("test", "test", sdk.MsgTypeURL(&types.MsgUpdateDid{}), payload, types.SignBytesMode_SIGN_BYTES_MODE_JCS)
+	signBytes, err := signPayload.GetSignBytes(types.SignDocVersion1, types.SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.NoError(t, err)
+
+	msg := &types.MsgUpdateDid{
+		Payload: payload,
+		Signatures: []*types.SignInfo{
+			{
+				VerificationMethodId: AliceKey1,
+				Signature:            base64.StdEncoding.EncodeToString(ed25519.Sign(keys[AliceKey1].PrivateKey, signBytes)),
+				SignDocVersion:       types.SignDocVersion1,
+				SignBytesMode:        types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+			},
+		},
+	}
+
+	_, err = setup.Handler(setup.Ctx, msg)
+	require.NoError(t, err)
+
+	updated, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	updatedDid, err := updated.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://example.com"}, updatedDid.AlsoKnownAs)
+}
+
+func TestJWSSignature(t *testing.T) {
+	setup := Setup()
+	keyPair := GenerateKeyPair()
+	payload := setup.CreateDid(keyPair.PublicKey, EveDID)
+
+	signPayload := types.NewSignPayloadForMode("test", "test", sdk.MsgTypeURL(&types.MsgCreateDid{}), payload, types.SignBytesMode_SIGN_BYTES_MODE_JCS)
+	signBytes, err := signPayload.GetSignBytes(types.SignDocVersion1, types.SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.NoError(t, err)
+
+	msg := &types.MsgCreateDid{
+		Payload:    payload,
+		Signatures: []*types.SignInfo{JWSSignInfo(EveKey1, keyPair.PrivateKey, signBytes)},
+	}
+
+	_, err = setup.Handler(setup.Ctx, msg)
+	require.NoError(t, err)
+	require.True(t, setup.Keeper.HasDid(&setup.Ctx, EveDID))
+}
+
+func TestJWSSignatureOnUpdate(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+	require.NoError(t, setup.CreateTestDIDs(keys))
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	did, err := state.UnpackDataAsDid()
+	require.NoError(t, err)
+
+	// Alice is the controller of both the old and the new version, so the key signs for both
+	payload := &types.MsgUpdateDidPayload{
+		Id:                 AliceDID,
+		VerificationMethod: did.VerificationMethod,
+		Authentication:     did.Authentication,
+		AlsoKnownAs:        []string{"https://example.com"},
+		VersionId:          state.Metadata.VersionId,
+	}
+
+	signPayload := types.NewSignPayloadForMode("test", "test", sdk.MsgTypeURL(&types.MsgUpdateDid{}), payload, types.SignBytesMode_SIGN_BYTES_MODE_JCS)
+	signBytes, err := signPayload.GetSignBytes(types.SignDocVersion1, types.SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.NoError(t, err)
+
+	_, err = setup.Handler(setup.Ctx, &types.MsgUpdateDid{
+		Payload:    payload,
+		Signatures: []*types.SignInfo{JWSSignInfo(AliceKey1, keys[AliceKey1].PrivateKey, signBytes)},
+	})
+	require.NoError(t, err)
+
+	updated, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	updatedDid, err := updated.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://example.com"}, updatedDid.AlsoKnownAs)
+}
+
+func TestJWSSignatureOnPatch(t *testing.T) {
+	setup := Setup()
+	keys := GenerateTestKeys()
+	require.NoError(t, setup.CreateTestDIDs(keys))
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	payload := &types.MsgPatchDidPayload{
+		Id:         AliceDID,
+		VersionId:  state.Metadata.VersionId,
+		Operations: []*types.DidPatchOperation{{Op: types.PatchOpAdd, Field: types.PatchFieldAlsoKnownAs, Value: "https://example.com"}},
+	}
+
+	signPayload := types.NewSignPayloadForMode("test", "test", sdk.MsgTypeURL(&types.MsgPatchDid{}), payload, types.SignBytesMode_SIGN_BYTES_MODE_JCS)
+	signBytes, err := signPayload.GetSignBytes(types.SignDocVersion1, types.SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.NoError(t, err)
+
+	// The kid is the id the key has in the stored DID Doc
+	_, err = setup.Handler(setup.Ctx, &types.MsgPatchDid{
+		Payload:    payload,
+		Signatures: []*types.SignInfo{JWSSignInfo(AliceKey1, keys[AliceKey1].PrivateKey, signBytes)},
+	})
+	require.NoError(t, err)
+
+	patched, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	patchedDid, err := patched.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://example.com"}, patchedDid.AlsoKnownAs)
+}
+
+// JWSSignInfo signs the sign bytes as a detached JWS in JCS mode
+func JWSSignInfo(kid string, key ed25519.PrivateKey, signBytes []byte) *types.SignInfo {
+	header := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"EdDSA","kid":"%s"}`, kid)))
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(signBytes)
+	signature := base64.RawURLEncoding.EncodeToString(ed25519.Sign(key, []byte(signingInput)))
+
+	return &types.SignInfo{
+		VerificationMethodId: kid,
+		Signature:            header + ".." + signature,
+		SignDocVersion:       types.SignDocVersion1,
+		SignBytesMode:        types.SignBytesMode_SIGN_BYTES_MODE_JCS,
+		SignatureFormat:      types.SignatureFormat_SIGNATURE_FORMAT_JWS,
+	}
+}
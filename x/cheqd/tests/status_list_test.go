@@ -0,0 +1,252 @@
+package tests
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+func DecodeStatusList(t *testing.T, encodedList string) []byte {
+	compressed, err := base64.StdEncoding.DecodeString(encodedList)
+	require.NoError(t, err)
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+
+	bits, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+
+	return bits
+}
+
+func StatusListVersion(t *testing.T, setup TestSetup, id string) string {
+	stateValue, err := setup.Keeper.GetStatusList(setup.Ctx, id)
+	require.NoError(t, err)
+
+	return stateValue.Metadata.VersionId
+}
+
+func TestCreateStatusList(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	// Bob's key is an authentication key only
+	bobPubKey, bobPrivKey, _ := ed25519.GenerateKey(rand.Reader)
+	bobDid := setup.CreateDid(bobPubKey, BobDID)
+	bobDid.AssertionMethod = nil
+	bobKeys := map[string]ed25519.PrivateKey{BobDID + "#key-1": bobPrivKey}
+	_, err = setup.SendCreateDid(bobDid, bobKeys)
+	require.NoError(t, err)
+
+	// Eve has two assertion keys
+	evePubKey1, evePrivKey1, _ := ed25519.GenerateKey(rand.Reader)
+	evePubKey2, evePrivKey2, _ := ed25519.GenerateKey(rand.Reader)
+	eveDid := setup.CreateDid(evePubKey1, EveDID)
+	eveKey2 := *eveDid.VerificationMethod[0]
+	eveKey2.Id = EveDID + "#key-2"
+	eveKey2.PublicKeyMultibase = "z" + base58.Encode(evePubKey2)
+	eveDid.VerificationMethod = append(eveDid.VerificationMethod, &eveKey2)
+	eveDid.AssertionMethod = []string{EveDID + "#key-1", EveDID + "#key-2"}
+	eveKeys := map[string]ed25519.PrivateKey{EveDID + "#key-1": evePrivKey1, EveDID + "#key-2": evePrivKey2}
+	_, err = setup.SendCreateDid(eveDid, eveKeys)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		payload *types.MsgCreateStatusListPayload
+		signers []SignerKey
+		err     error
+		errMsg  string
+	}{
+		{
+			name:    "Valid: Owner signs with an assertion key",
+			payload: NewStatusListPayload(StatusListId, AliceDID, StatusListSize),
+			signers: MapToListOfSignerKeys(aliceKeys),
+		},
+		{
+			name:    "Not Valid: Id is already used",
+			payload: NewStatusListPayload(StatusListId, AliceDID, StatusListSize),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrStatusListExists,
+		},
+		{
+			name:    "Valid: An invalid assertion signature is followed by a valid one",
+			payload: NewStatusListPayload("did:cheqd:test:5555555555555555", EveDID, StatusListSize),
+			signers: []SignerKey{
+				{signer: EveDID + "#key-1", key: bobPrivKey},
+				{signer: EveDID + "#key-2", key: eveKeys[EveDID+"#key-2"]},
+			},
+		},
+		{
+			name:    "Not Valid: All assertion signatures are invalid",
+			payload: NewStatusListPayload("did:cheqd:test:6666666666666666", AliceDID, StatusListSize),
+			signers: []SignerKey{{signer: AliceDID + "#key-1", key: bobPrivKey}},
+			err:     types.ErrInvalidSignature,
+		},
+		{
+			name:    "Not Valid: Owner signs with a key that isn't an assertion method",
+			payload: NewStatusListPayload("did:cheqd:test:1111111111111111", BobDID, StatusListSize),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrSignatureNotFound,
+			errMsg:  "assertion method signature of did:cheqd:test:bbbbbbbbbbbbbbbb: signature is required but not found",
+		},
+		{
+			name:    "Not Valid: Signed by someone else",
+			payload: NewStatusListPayload("did:cheqd:test:2222222222222222", AliceDID, StatusListSize),
+			signers: MapToListOfSignerKeys(bobKeys),
+			err:     types.ErrSignatureNotFound,
+		},
+		{
+			name:    "Not Valid: Owner doesn't exist",
+			payload: NewStatusListPayload("did:cheqd:test:3333333333333333", CharlieDID, StatusListSize),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrDidDocNotFound,
+		},
+		{
+			name:    "Not Valid: Size isn't a multiple of 8",
+			payload: NewStatusListPayload("did:cheqd:test:4444444444444444", AliceDID, 100),
+			signers: MapToListOfSignerKeys(aliceKeys),
+			err:     types.ErrNamespaceValidation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusList, err := setup.SendCreateStatusList(tc.payload, tc.signers)
+
+			if tc.err == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.payload.ToStatusList(), *statusList)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				if tc.errMsg != "" {
+					require.Equal(t, tc.errMsg, err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateStatusList(t *testing.T) {
+	setup := Setup()
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	bobKeys, _, err := setup.InitDid(BobDID)
+	require.NoError(t, err)
+
+	_, err = setup.SendCreateStatusList(NewStatusListPayload(StatusListId, AliceDID, StatusListSize), MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	// All bits are cleared initially
+	resp, err := setup.Keeper.StatusList(ctx, &types.QueryGetStatusListRequest{Id: StatusListId})
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, StatusListSize/8), DecodeStatusList(t, resp.EncodedList))
+
+	version := StatusListVersion(t, setup, StatusListId)
+
+	// Only the owner can update the list
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{Id: StatusListId, Set: []uint64{1}, VersionId: version}, MapToListOfSignerKeys(bobKeys))
+	require.ErrorIs(t, err, types.ErrSignatureNotFound)
+
+	// Indices must fit into the list
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{Id: StatusListId, Set: []uint64{StatusListSize}, VersionId: version}, MapToListOfSignerKeys(aliceKeys))
+	require.ErrorIs(t, err, types.ErrStatusListIndexOutOfRange)
+
+	// The result of setting and clearing the same index would depend on the order the lists are applied in
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{Id: StatusListId, Set: []uint64{1, 2}, Clear: []uint64{2}, VersionId: version}, MapToListOfSignerKeys(aliceKeys))
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	require.Contains(t, err.Error(), "index 2 can't be both set and cleared")
+
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{Id: "did:cheqd:test:zzzzzzzzzzzzzzzz", Set: []uint64{1}, VersionId: version}, MapToListOfSignerKeys(aliceKeys))
+	require.ErrorIs(t, err, types.ErrStatusListNotFound)
+
+	// Set bits in different chunks
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{
+		Id:        StatusListId,
+		Set:       []uint64{0, 9, 2048, 94567, StatusListSize - 1},
+		VersionId: version,
+	}, MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	// Only the touched chunks are written
+	require.Len(t, setup.Keeper.GetAllStatusListChunks(setup.Ctx), 4)
+
+	// Clear and set in a batch
+	_, err = setup.SendUpdateStatusList(&types.MsgUpdateStatusListPayload{
+		Id:        StatusListId,
+		Set:       []uint64{10},
+		Clear:     []uint64{9, 2048},
+		VersionId: StatusListVersion(t, setup, StatusListId),
+	}, MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	expected := make([]byte, StatusListSize/8)
+	for _, index := range []uint64{0, 10, 94567, StatusListSize - 1} {
+		types.SetBit(expected, index, true)
+	}
+
+	resp, err = setup.Keeper.StatusList(ctx, &types.QueryGetStatusListRequest{Id: StatusListId})
+	require.NoError(t, err)
+	require.Equal(t, expected, DecodeStatusList(t, resp.EncodedList))
+
+	// Bit 0 is the most significant bit of the first byte
+	require.Equal(t, []byte{0x80, 0x20}, DecodeStatusList(t, resp.EncodedList)[:2])
+	require.NotEmpty(t, resp.Metadata.Updated)
+
+	// Single index checks
+	for index, set := range map[uint64]bool{0: true, 9: false, 10: true, 2048: false, 94567: true, StatusListSize - 1: true, 5: false} {
+		indexResp, err := setup.Keeper.StatusListIndex(ctx, &types.QueryGetStatusListIndexRequest{Id: StatusListId, Index: index})
+		require.NoError(t, err)
+		require.Equal(t, set, indexResp.Set, "index %d", index)
+	}
+
+	_, err = setup.Keeper.StatusListIndex(ctx, &types.QueryGetStatusListIndexRequest{Id: StatusListId, Index: StatusListSize})
+	require.ErrorIs(t, err, types.ErrStatusListIndexOutOfRange)
+}
+
+func TestUpdateStatusListReplay(t *testing.T) {
+	setup := Setup()
+
+	aliceKeys, _, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("create"))
+	_, err = setup.SendCreateStatusList(NewStatusListPayload(StatusListId, AliceDID, StatusListSize), MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	revoke := &types.MsgUpdateStatusListPayload{Id: StatusListId, Set: []uint64{7}, VersionId: StatusListVersion(t, setup, StatusListId)}
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("revoke"))
+	_, err = setup.SendUpdateStatusList(revoke, MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+	require.Equal(t, types.NextVersionId(setup.Ctx, revoke.VersionId), StatusListVersion(t, setup, StatusListId))
+
+	unrevoke := &types.MsgUpdateStatusListPayload{Id: StatusListId, Clear: []uint64{7}, VersionId: StatusListVersion(t, setup, StatusListId)}
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("unrevoke"))
+	_, err = setup.SendUpdateStatusList(unrevoke, MapToListOfSignerKeys(aliceKeys))
+	require.NoError(t, err)
+
+	// The signed revocation can't be applied again by anyone
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("replay"))
+	_, err = setup.SendUpdateStatusList(revoke, MapToListOfSignerKeys(aliceKeys))
+	require.ErrorIs(t, err, types.ErrStatusListVersionMismatch)
+
+	resp, err := setup.Keeper.StatusListIndex(sdk.WrapSDKContext(setup.Ctx), &types.QueryGetStatusListIndexRequest{Id: StatusListId, Index: 7})
+	require.NoError(t, err)
+	require.False(t, resp.Set)
+}
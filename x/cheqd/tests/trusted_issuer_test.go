@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	DiplomaCredential = "UniversityDegreeCredential"
+	LicenseCredential = "https://example.com/credentials#DrivingLicense"
+)
+
+func NewTestTrustedIssuer(issuer, credentialType string) *types.TrustedIssuer {
+	validFrom, _ := time.Parse(time.RFC3339, "2021-01-01T00:00:00Z")
+	trustedIssuer := types.NewTrustedIssuer(issuer, credentialType, validFrom, nil)
+	return &trustedIssuer
+}
+
+func TestAddTrustedIssuersProposal(t *testing.T) {
+	setup := InitEnv(t, GenerateTestKeys())
+
+	_, err := setup.SubmitProposal(types.NewAddTrustedIssuersProposal("title", "description", []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(AliceDID, LicenseCredential),
+		NewTestTrustedIssuer(BobDID, DiplomaCredential),
+	}))
+	require.NoError(t, err)
+
+	trustedIssuer, found := setup.Keeper.GetTrustedIssuer(setup.Ctx, types.NewTrustedIssuerRef(AliceDID, LicenseCredential))
+	require.True(t, found)
+	require.Equal(t, *NewTestTrustedIssuer(AliceDID, LicenseCredential), trustedIssuer)
+	require.True(t, trustedIssuer.IsValidAt(setup.Ctx.BlockTime()))
+
+	require.Len(t, setup.Keeper.GetAllTrustedIssuers(setup.Ctx), 3)
+}
+
+func TestAddTrustedIssuersProposalFails(t *testing.T) {
+	cases := []struct {
+		name   string
+		issuer *types.TrustedIssuer
+		err    error
+	}{
+		{"Not Valid: Issuer DID doesn't exist", NewTestTrustedIssuer(NotFounDID, DiplomaCredential), types.ErrDidDocNotFound},
+		{"Not Valid: Issuer DID is deactivated", NewTestTrustedIssuer(BobDID, DiplomaCredential), types.ErrDidDocDeactivated},
+		{"Not Valid: Credential type contains whitespaces", NewTestTrustedIssuer(AliceDID, "Degree Credential"), types.ErrBasicValidation},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			setup := InitEnv(t, GenerateTestKeys())
+
+			_, err := setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", BobDID, nil))
+			require.NoError(t, err)
+
+			// Valid entries of the proposal aren't applied either
+			_, err = setup.SubmitProposal(types.NewAddTrustedIssuersProposal("title", "description", []*types.TrustedIssuer{
+				NewTestTrustedIssuer(AliceDID, LicenseCredential),
+				tc.issuer,
+			}))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestRemoveTrustedIssuersProposal(t *testing.T) {
+	setup := InitEnv(t, GenerateTestKeys())
+
+	_, err := setup.SubmitProposal(types.NewAddTrustedIssuersProposal("title", "description", []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(BobDID, DiplomaCredential),
+	}))
+	require.NoError(t, err)
+
+	ref := types.NewTrustedIssuerRef(AliceDID, DiplomaCredential)
+	_, err = setup.SubmitProposal(types.NewRemoveTrustedIssuersProposal("title", "description", []*types.TrustedIssuerRef{&ref}))
+	require.NoError(t, err)
+
+	_, found := setup.Keeper.GetTrustedIssuer(setup.Ctx, ref)
+	require.False(t, found)
+
+	resp, err := setup.Keeper.TrustedIssuersByCredentialType(sdk.WrapSDKContext(setup.Ctx), &types.QueryTrustedIssuersByCredentialTypeRequest{CredentialType: DiplomaCredential})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TrustedIssuer{NewTestTrustedIssuer(BobDID, DiplomaCredential)}, resp.TrustedIssuers)
+
+	// Removed entries can't be removed again
+	_, err = setup.SubmitProposal(types.NewRemoveTrustedIssuersProposal("title", "description", []*types.TrustedIssuerRef{&ref}))
+	require.ErrorIs(t, err, types.ErrTrustedIssuerNotFound)
+}
+
+func TestDeactivatedDidIsRemovedFromTrustedIssuers(t *testing.T) {
+	setup := InitEnv(t, GenerateTestKeys())
+
+	_, err := setup.SubmitProposal(types.NewAddTrustedIssuersProposal("title", "description", []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(AliceDID, LicenseCredential),
+		NewTestTrustedIssuer(BobDID, DiplomaCredential),
+	}))
+	require.NoError(t, err)
+
+	_, err = setup.SubmitProposal(types.NewDeactivateDidProposal("title", "description", AliceDID, nil))
+	require.NoError(t, err)
+
+	require.Empty(t, setup.Keeper.GetTrustedIssuersByIssuer(setup.Ctx, AliceDID))
+	require.Len(t, setup.Keeper.GetAllTrustedIssuers(setup.Ctx), 1)
+}
+
+func TestTrustedIssuersQueries(t *testing.T) {
+	setup := InitEnv(t, GenerateTestKeys())
+
+	_, err := setup.SubmitProposal(types.NewAddTrustedIssuersProposal("title", "description", []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(AliceDID, LicenseCredential),
+		NewTestTrustedIssuer(BobDID, DiplomaCredential),
+		NewTestTrustedIssuer(CharlieDID, DiplomaCredential),
+	}))
+	require.NoError(t, err)
+
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	// By issuer
+	byIssuer, err := setup.Keeper.TrustedIssuersByIssuer(ctx, &types.QueryTrustedIssuersByIssuerRequest{Issuer: AliceDID})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(AliceDID, LicenseCredential),
+	}, byIssuer.TrustedIssuers)
+
+	// By credential type, page by page
+	firstPage, err := setup.Keeper.TrustedIssuersByCredentialType(ctx, &types.QueryTrustedIssuersByCredentialTypeRequest{
+		CredentialType: DiplomaCredential,
+		Pagination:     &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TrustedIssuer{
+		NewTestTrustedIssuer(AliceDID, DiplomaCredential),
+		NewTestTrustedIssuer(BobDID, DiplomaCredential),
+	}, firstPage.TrustedIssuers)
+	require.Equal(t, uint64(3), firstPage.Pagination.Total)
+
+	secondPage, err := setup.Keeper.TrustedIssuersByCredentialType(ctx, &types.QueryTrustedIssuersByCredentialTypeRequest{
+		CredentialType: DiplomaCredential,
+		Pagination:     &query.PageRequest{Key: firstPage.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TrustedIssuer{NewTestTrustedIssuer(CharlieDID, DiplomaCredential)}, secondPage.TrustedIssuers)
+
+	// Credential types sharing a prefix aren't mixed up
+	none, err := setup.Keeper.TrustedIssuersByCredentialType(ctx, &types.QueryTrustedIssuersByCredentialTypeRequest{CredentialType: "University"})
+	require.NoError(t, err)
+	require.Empty(t, none.TrustedIssuers)
+}
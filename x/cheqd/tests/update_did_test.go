@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	"github.com/multiformats/go-multibase"
 	"github.com/stretchr/testify/require"
 )
@@ -481,3 +482,96 @@ func TestUpdateDid(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateDidStaleVersion(t *testing.T) {
+	setup := Setup()
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("create tx"))
+	keys, createMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	signers := MapToListOfSignerKeys(keys)
+
+	// Both updates are signed for the created version
+	addAka := setup.CreateToUpdateDid(createMsg)
+	addAka.VersionId = utils.GetTxHash([]byte("create tx"))
+	addAka.AlsoKnownAs = []string{"did:example:alice"}
+
+	addController := setup.CreateToUpdateDid(createMsg)
+	addController.VersionId = utils.GetTxHash([]byte("create tx"))
+	addController.Controller = []string{AliceDID}
+
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("update tx"))
+	_, err = setup.SendUpdateDid(addAka, signers)
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.Equal(t, types.NextVersionId(setup.Ctx, addAka.VersionId), state.Metadata.VersionId)
+
+	// The second update would overwrite the first one
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("stale update tx"))
+	_, err = setup.SendUpdateDid(addController, signers)
+	require.ErrorIs(t, err, types.ErrUnexpectedDidVersion)
+
+	// The first update can't be replayed either
+	setup.Ctx = setup.Ctx.WithTxBytes([]byte("replay tx"))
+	_, err = setup.SendUpdateDid(addAka, signers)
+	require.ErrorIs(t, err, types.ErrUnexpectedDidVersion)
+}
+
+func TestUpdateDidTwiceInTx(t *testing.T) {
+	setup := Setup()
+
+	keys, createMsg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	signers := MapToListOfSignerKeys(keys)
+
+	created, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+
+	// Created and updated in the same transaction
+	addAka := setup.CreateToUpdateDid(createMsg)
+	addAka.AlsoKnownAs = []string{"did:example:alice"}
+	_, err = setup.SendUpdateDid(addAka, signers)
+	require.NoError(t, err)
+
+	state, err := setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	firstVersionId := state.Metadata.VersionId
+
+	// The second update in the transaction gets its own version
+	addController := setup.CreateToUpdateDid(createMsg)
+	addController.AlsoKnownAs = addAka.AlsoKnownAs
+	addController.Controller = []string{AliceDID}
+	_, err = setup.SendUpdateDid(addController, signers)
+	require.NoError(t, err)
+
+	state, err = setup.Keeper.GetDid(&setup.Ctx, AliceDID)
+	require.NoError(t, err)
+	require.NotEqual(t, firstVersionId, state.Metadata.VersionId)
+
+	first, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, firstVersionId)
+	require.NoError(t, err)
+
+	did, err := first.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{"did:example:alice"}, did.AlsoKnownAs)
+	require.Empty(t, did.Controller)
+
+	second, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, state.Metadata.VersionId)
+	require.NoError(t, err)
+
+	did, err = second.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, []string{AliceDID}, did.Controller)
+
+	// The created version is kept too
+	createdVersion, err := setup.Keeper.GetDidVersion(&setup.Ctx, AliceDID, created.Metadata.VersionId)
+	require.NoError(t, err)
+
+	did, err = createdVersion.UnpackDataAsDid()
+	require.NoError(t, err)
+	require.Equal(t, createMsg.AlsoKnownAs, did.AlsoKnownAs)
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const UUIDDID = "did:cheqd:test:3b9b8eec-5b5d-4382-86d8-9185126ff130"
+
+func TestCreateUUIDDid(t *testing.T) {
+	setup := Setup()
+
+	_, msg, err := setup.InitDid(UUIDDID)
+	require.NoError(t, err)
+
+	ctx := sdk.WrapSDKContext(setup.Ctx)
+
+	// The DID can be resolved by its id in any case
+	for _, id := range []string{UUIDDID, UUIDDID[:15] + strings.ToUpper(UUIDDID[15:])} {
+		resp, err := setup.Keeper.Did(ctx, &types.QueryGetDidRequest{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, msg.Id, resp.Did.Id)
+
+		status, err := setup.Keeper.IdStatus(ctx, &types.QueryIdStatusRequest{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, types.IdStatus_ID_STATUS_IN_USE, status.Status)
+	}
+}
+
+func TestCreateUUIDDidNotNormalised(t *testing.T) {
+	setup := Setup()
+
+	_, _, err := setup.InitDid("did:cheqd:test:3B9B8EEC-5B5D-4382-86D8-9185126FF130")
+	require.ErrorIs(t, err, types.ErrNamespaceValidation)
+	require.Contains(t, err.Error(), "unique id in UUID format must be lowercase: 3b9b8eec-5b5d-4382-86d8-9185126ff130")
+}
+
+func TestUUIDDidAsController(t *testing.T) {
+	setup := Setup()
+
+	uuidKeys, _, err := setup.InitDid(UUIDDID)
+	require.NoError(t, err)
+
+	aliceKeys, msg, err := setup.InitDid(AliceDID)
+	require.NoError(t, err)
+
+	updateMsg := setup.CreateToUpdateDid(msg)
+	updateMsg.Controller = []string{AliceDID, UUIDDID}
+
+	_, err = setup.SendUpdateDid(updateMsg, append(MapToListOfSignerKeys(aliceKeys), MapToListOfSignerKeys(uuidKeys)...))
+	require.NoError(t, err)
+}
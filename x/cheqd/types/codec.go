@@ -5,16 +5,45 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	// Sdk messages
 	cdc.RegisterConcrete(&MsgCreateDid{}, "cheqd/CreateDid", nil)
 	cdc.RegisterConcrete(&MsgUpdateDid{}, "cheqd/UpdateDid", nil)
+	cdc.RegisterConcrete(&MsgPatchDid{}, "cheqd/PatchDid", nil)
+	cdc.RegisterConcrete(&MsgCreateDidBatch{}, "cheqd/CreateDidBatch", nil)
+	cdc.RegisterConcrete(&MsgCreateSchema{}, "cheqd/CreateSchema", nil)
+	cdc.RegisterConcrete(&MsgCreateCredDef{}, "cheqd/CreateCredDef", nil)
+	cdc.RegisterConcrete(&MsgCreateRevocRegDef{}, "cheqd/CreateRevocRegDef", nil)
+	cdc.RegisterConcrete(&MsgUpdateRevocRegDef{}, "cheqd/UpdateRevocRegDef", nil)
+	cdc.RegisterConcrete(&MsgCreateRevocRegEntry{}, "cheqd/CreateRevocRegEntry", nil)
+	cdc.RegisterConcrete(&MsgUpdateRevocRegEntry{}, "cheqd/UpdateRevocRegEntry", nil)
+	cdc.RegisterConcrete(&MsgCreateStatusList{}, "cheqd/CreateStatusList", nil)
+	cdc.RegisterConcrete(&MsgUpdateStatusList{}, "cheqd/UpdateStatusList", nil)
+	cdc.RegisterConcrete(&MsgCreateResource{}, "cheqd/CreateResource", nil)
+	cdc.RegisterConcrete(&MsgLockPayment{}, "cheqd/LockPayment", nil)
+	cdc.RegisterConcrete(&MsgClaimPayment{}, "cheqd/ClaimPayment", nil)
+	cdc.RegisterConcrete(&MsgReclaimPayment{}, "cheqd/ReclaimPayment", nil)
+
+	// Governance proposals
+	cdc.RegisterConcrete(&SetNamespacesProposal{}, "cheqd/SetNamespacesProposal", nil)
+	cdc.RegisterConcrete(&DeactivateDidProposal{}, "cheqd/DeactivateDidProposal", nil)
+	cdc.RegisterConcrete(&AddTrustedIssuersProposal{}, "cheqd/AddTrustedIssuersProposal", nil)
+	cdc.RegisterConcrete(&RemoveTrustedIssuersProposal{}, "cheqd/RemoveTrustedIssuersProposal", nil)
+	cdc.RegisterConcrete(&SetReservedIdsProposal{}, "cheqd/SetReservedIdsProposal", nil)
+	cdc.RegisterConcrete(&ReleaseReservedIdsProposal{}, "cheqd/ReleaseReservedIdsProposal", nil)
 
 	// State value data
 	cdc.RegisterInterface((*StateValueData)(nil), nil)
 	cdc.RegisterConcrete(&Did{}, "cheqd/Did", nil)
+	cdc.RegisterConcrete(&Schema{}, "cheqd/Schema", nil)
+	cdc.RegisterConcrete(&CredDef{}, "cheqd/CredDef", nil)
+	cdc.RegisterConcrete(&RevocRegDef{}, "cheqd/RevocRegDef", nil)
+	cdc.RegisterConcrete(&RevocRegEntry{}, "cheqd/RevocRegEntry", nil)
+	cdc.RegisterConcrete(&StatusList{}, "cheqd/StatusList", nil)
+	cdc.RegisterConcrete(&Resource{}, "cheqd/Resource", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,11 +51,43 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDid{},
 		&MsgUpdateDid{},
+		&MsgPatchDid{},
+		&MsgCreateDidBatch{},
+		&MsgCreateSchema{},
+		&MsgCreateCredDef{},
+		&MsgCreateRevocRegDef{},
+		&MsgUpdateRevocRegDef{},
+		&MsgCreateRevocRegEntry{},
+		&MsgUpdateRevocRegEntry{},
+		&MsgCreateStatusList{},
+		&MsgUpdateStatusList{},
+		&MsgCreateResource{},
+		&MsgLockPayment{},
+		&MsgClaimPayment{},
+		&MsgReclaimPayment{},
+	)
+
+	// Governance proposals
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&SetNamespacesProposal{},
+		&DeactivateDidProposal{},
+		&AddTrustedIssuersProposal{},
+		&RemoveTrustedIssuersProposal{},
+		&SetReservedIdsProposal{},
+		&ReleaseReservedIdsProposal{},
 	)
 
 	// State value data
 	registry.RegisterInterface("StateValueData", (*StateValueData)(nil))
-	registry.RegisterImplementations((*StateValueData)(nil), &Did{})
+	registry.RegisterImplementations((*StateValueData)(nil),
+		&Did{},
+		&Schema{},
+		&CredDef{},
+		&RevocRegDef{},
+		&RevocRegEntry{},
+		&StatusList{},
+		&Resource{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,33 @@
+package types
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ StateValueData = &CredDef{}
+
+const CredDefSignatureTypeCL = "CL"
+
+func NewCredDef(id string, schemaId string, signatureType string, tag string, value string, controller []string) CredDef {
+	return CredDef{
+		Id:            id,
+		SchemaId:      schemaId,
+		SignatureType: signatureType,
+		Tag:           tag,
+		Value:         value,
+		Controller:    controller,
+	}
+}
+
+// Validation
+
+func (credDef CredDef) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&credDef,
+		validation.Field(&credDef.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&credDef.SchemaId, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&credDef.SignatureType, validation.Required, validation.In(CredDefSignatureTypeCL)),
+		validation.Field(&credDef.Tag, validation.Required),
+		validation.Field(&credDef.Value, validation.Required, IsJSONObject("primary")),
+		validation.Field(&credDef.Controller, validation.Required, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
+	)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredDefValidation(t *testing.T) {
+	const (
+		credDefId  = "did:cheqd:test:dddddddddddddddd"
+		schemaId   = "did:cheqd:test:ssssssssssssssss"
+		controller = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+		value      = `{"primary": {"n": "779...397"}, "revocation": {"g": "1 163...A8F"}}`
+	)
+
+	cases := []struct {
+		name     string
+		credDef  CredDef
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: CL cred def",
+			credDef: NewCredDef(credDefId, schemaId, CredDefSignatureTypeCL, "default", value, []string{controller}),
+			isValid: true,
+		},
+		{
+			name:     "negative: unsupported signature type",
+			credDef:  NewCredDef(credDefId, schemaId, "BBS+", "default", value, []string{controller}),
+			isValid:  false,
+			errorMsg: "signature_type: must be a valid value.",
+		},
+		{
+			name:     "negative: invalid schema id",
+			credDef:  NewCredDef(credDefId, "1:2:3", CredDefSignatureTypeCL, "default", value, []string{controller}),
+			isValid:  false,
+			errorMsg: "schema_id: unable to split did into method, namespace and id.",
+		},
+		{
+			name:     "negative: value is not JSON",
+			credDef:  NewCredDef(credDefId, schemaId, CredDefSignatureTypeCL, "default", "primary", []string{controller}),
+			isValid:  false,
+			errorMsg: "value: must be a JSON object.",
+		},
+		{
+			name:     "negative: value without primary key",
+			credDef:  NewCredDef(credDefId, schemaId, CredDefSignatureTypeCL, "default", `{"revocation": {}}`, []string{controller}),
+			isValid:  false,
+			errorMsg: "value: primary key is required.",
+		},
+		{
+			name:     "negative: no tag and controller",
+			credDef:  NewCredDef(credDefId, schemaId, CredDefSignatureTypeCL, "", value, nil),
+			isValid:  false,
+			errorMsg: "controller: cannot be blank; tag: cannot be blank.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.credDef.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
@@ -7,6 +7,12 @@ import (
 
 var _ StateValueData = &Did{}
 
+// DID Doc fields that can be redacted by governance
+const (
+	DidFieldService     = "service"
+	DidFieldAlsoKnownAs = "alsoKnownAs"
+)
+
 func NewDid(context []string, id string, controller []string, verificationMethod []*VerificationMethod,
 	authentication []string, assertionMethod []string, capabilityInvocation []string, capabilityDelegation []string,
 	keyAgreement []string, service []*Service, alsoKnownAs []string,
@@ -62,6 +68,18 @@ func (did *Did) ReplaceIds(old, new string) {
 	}
 }
 
+// Redact clears the given fields of the DID Doc
+func (did *Did) Redact(fields []string) {
+	for _, field := range fields {
+		switch field {
+		case DidFieldService:
+			did.Service = nil
+		case DidFieldAlsoKnownAs:
+			did.AlsoKnownAs = nil
+		}
+	}
+}
+
 func (did *Did) GetControllersOrSubject() []string {
 	result := did.Controller
 
@@ -82,6 +100,23 @@ func (did *Did) GetVerificationMethodControllers() []string {
 	return result
 }
 
+// IsSigningVerificationMethod returns false if the verification method is referenced only as a key agreement key,
+// i.e. it is used for encryption and is not able to produce signatures
+func (did *Did) IsSigningVerificationMethod(vmId string) bool {
+	if !utils.Contains(did.KeyAgreement, vmId) {
+		return true
+	}
+
+	signingRelationships := [][]string{did.Authentication, did.AssertionMethod, did.CapabilityInvocation, did.CapabilityDelegation}
+	for _, relationship := range signingRelationships {
+		if utils.Contains(relationship, vmId) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Validation
 
 func (did Did) Validate(allowedNamespaces []string) error {
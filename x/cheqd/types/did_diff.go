@@ -0,0 +1,155 @@
+package types
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+)
+
+// NewDidDiff returns the change set between two versions of a DID Doc
+func NewDidDiff(from Did, fromVersionId string, to Did, toVersionId string) DidDiff {
+	return DidDiff{
+		Id:                   to.Id,
+		FromVersionId:        fromVersionId,
+		ToVersionId:          toVersionId,
+		Context:              newDidListDiff(from.Context, to.Context),
+		Controller:           newDidListDiff(from.Controller, to.Controller),
+		VerificationMethod:   newVerificationMethodsDiff(from.VerificationMethod, to.VerificationMethod),
+		Authentication:       newDidListDiff(from.Authentication, to.Authentication),
+		AssertionMethod:      newDidListDiff(from.AssertionMethod, to.AssertionMethod),
+		CapabilityInvocation: newDidListDiff(from.CapabilityInvocation, to.CapabilityInvocation),
+		CapabilityDelegation: newDidListDiff(from.CapabilityDelegation, to.CapabilityDelegation),
+		KeyAgreement:         newDidListDiff(from.KeyAgreement, to.KeyAgreement),
+		Service:              newServicesDiff(from.Service, to.Service),
+		AlsoKnownAs:          newDidListDiff(from.AlsoKnownAs, to.AlsoKnownAs),
+	}
+}
+
+// IsEmpty returns true if the versions are the same
+func (d DidDiff) IsEmpty() bool {
+	return len(d.ChangedFields()) == 0
+}
+
+// ChangedFields returns the names of the fields that are different in the two versions.
+// Names are the same as the ones used by patch operations.
+func (d DidDiff) ChangedFields() []string {
+	fields := []struct {
+		name    string
+		isEmpty bool
+	}{
+		{PatchFieldContext, d.Context.IsEmpty()},
+		{PatchFieldController, d.Controller.IsEmpty()},
+		{PatchFieldVerificationMethod, d.VerificationMethod.IsEmpty()},
+		{PatchFieldAuthentication, d.Authentication.IsEmpty()},
+		{PatchFieldAssertionMethod, d.AssertionMethod.IsEmpty()},
+		{PatchFieldCapabilityInvocation, d.CapabilityInvocation.IsEmpty()},
+		{PatchFieldCapabilityDelegation, d.CapabilityDelegation.IsEmpty()},
+		{PatchFieldKeyAgreement, d.KeyAgreement.IsEmpty()},
+		{PatchFieldService, d.Service.IsEmpty()},
+		{PatchFieldAlsoKnownAs, d.AlsoKnownAs.IsEmpty()},
+	}
+
+	var res []string
+
+	for _, field := range fields {
+		if !field.isEmpty {
+			res = append(res, field.name)
+		}
+	}
+
+	return res
+}
+
+func (d *DidListDiff) IsEmpty() bool {
+	return d == nil || len(d.Added)+len(d.Removed) == 0
+}
+
+func (d *DidEntryDiff) IsEmpty() bool {
+	return d == nil || len(d.Added)+len(d.Removed)+len(d.Changed) == 0
+}
+
+func newDidListDiff(from []string, to []string) *DidListDiff {
+	added := utils.Subtract(to, from)
+	removed := utils.Subtract(from, to)
+
+	if len(added)+len(removed) == 0 {
+		return nil
+	}
+
+	return &DidListDiff{Added: sortedOrNil(added), Removed: sortedOrNil(removed)}
+}
+
+func newVerificationMethodsDiff(from []*VerificationMethod, to []*VerificationMethod) *DidEntryDiff {
+	fromMap := VerificationMethodListToMapByFragment(from)
+	toMap := VerificationMethodListToMapByFragment(to)
+
+	var changed []string
+
+	for fragment, toVm := range toMap {
+		if fromVm, ok := fromMap[fragment]; ok && !CompareVerificationMethodsWithoutIds(fromVm, toVm) {
+			changed = append(changed, toVm.Id)
+		}
+	}
+
+	return newDidEntryDiff(verificationMethodIds(from), verificationMethodIds(to), changed)
+}
+
+func newServicesDiff(from []*Service, to []*Service) *DidEntryDiff {
+	fromMap := map[string]Service{}
+	for _, service := range from {
+		fromMap[service.Id] = *service
+	}
+
+	var changed []string
+
+	for _, toService := range to {
+		if fromService, ok := fromMap[toService.Id]; ok && !reflect.DeepEqual(fromService, *toService) {
+			changed = append(changed, toService.Id)
+		}
+	}
+
+	return newDidEntryDiff(serviceIds(from), serviceIds(to), changed)
+}
+
+func newDidEntryDiff(fromIds []string, toIds []string, changed []string) *DidEntryDiff {
+	listDiff := newDidListDiff(fromIds, toIds)
+
+	if listDiff == nil && len(changed) == 0 {
+		return nil
+	}
+
+	if listDiff == nil {
+		listDiff = &DidListDiff{}
+	}
+
+	return &DidEntryDiff{Added: listDiff.Added, Removed: listDiff.Removed, Changed: sortedOrNil(changed)}
+}
+
+// sortedOrNil makes the change set deterministic, empty lists are the same as missing ones
+func sortedOrNil(list []string) []string {
+	if len(list) == 0 {
+		return nil
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+func verificationMethodIds(vms []*VerificationMethod) []string {
+	result := make([]string, 0, len(vms))
+	for _, vm := range vms {
+		result = append(result, vm.Id)
+	}
+
+	return result
+}
+
+func serviceIds(services []*Service) []string {
+	result := make([]string, 0, len(services))
+	for _, service := range services {
+		result = append(result, service.Id)
+	}
+
+	return result
+}
@@ -0,0 +1,1555 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/did_diff.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DidDiff is the change set between two versions of a DID Doc
+type DidDiff struct {
+	Id            string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromVersionId string       `protobuf:"bytes,2,opt,name=from_version_id,json=fromVersionId,proto3" json:"from_version_id,omitempty"`
+	ToVersionId   string       `protobuf:"bytes,3,opt,name=to_version_id,json=toVersionId,proto3" json:"to_version_id,omitempty"`
+	Context       *DidListDiff `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Controller    *DidListDiff `protobuf:"bytes,5,opt,name=controller,proto3" json:"controller,omitempty"`
+	// verification_method entries are verification method ids, changed ones have the same id and different content
+	VerificationMethod   *DidEntryDiff `protobuf:"bytes,6,opt,name=verification_method,json=verificationMethod,proto3" json:"verification_method,omitempty"`
+	Authentication       *DidListDiff  `protobuf:"bytes,7,opt,name=authentication,proto3" json:"authentication,omitempty"`
+	AssertionMethod      *DidListDiff  `protobuf:"bytes,8,opt,name=assertion_method,json=assertionMethod,proto3" json:"assertion_method,omitempty"`
+	CapabilityInvocation *DidListDiff  `protobuf:"bytes,9,opt,name=capability_invocation,json=capabilityInvocation,proto3" json:"capability_invocation,omitempty"`
+	CapabilityDelegation *DidListDiff  `protobuf:"bytes,10,opt,name=capability_delegation,json=capabilityDelegation,proto3" json:"capability_delegation,omitempty"`
+	KeyAgreement         *DidListDiff  `protobuf:"bytes,11,opt,name=key_agreement,json=keyAgreement,proto3" json:"key_agreement,omitempty"`
+	// service entries are service ids, changed ones have the same id and different content
+	Service     *DidEntryDiff `protobuf:"bytes,12,opt,name=service,proto3" json:"service,omitempty"`
+	AlsoKnownAs *DidListDiff  `protobuf:"bytes,13,opt,name=also_known_as,json=alsoKnownAs,proto3" json:"also_known_as,omitempty"`
+}
+
+func (m *DidDiff) Reset()         { *m = DidDiff{} }
+func (m *DidDiff) String() string { return proto.CompactTextString(m) }
+func (*DidDiff) ProtoMessage()    {}
+func (*DidDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f455d02d8d4dc5, []int{0}
+}
+func (m *DidDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidDiff.Merge(m, src)
+}
+func (m *DidDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidDiff proto.InternalMessageInfo
+
+func (m *DidDiff) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DidDiff) GetFromVersionId() string {
+	if m != nil {
+		return m.FromVersionId
+	}
+	return ""
+}
+
+func (m *DidDiff) GetToVersionId() string {
+	if m != nil {
+		return m.ToVersionId
+	}
+	return ""
+}
+
+func (m *DidDiff) GetContext() *DidListDiff {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *DidDiff) GetController() *DidListDiff {
+	if m != nil {
+		return m.Controller
+	}
+	return nil
+}
+
+func (m *DidDiff) GetVerificationMethod() *DidEntryDiff {
+	if m != nil {
+		return m.VerificationMethod
+	}
+	return nil
+}
+
+func (m *DidDiff) GetAuthentication() *DidListDiff {
+	if m != nil {
+		return m.Authentication
+	}
+	return nil
+}
+
+func (m *DidDiff) GetAssertionMethod() *DidListDiff {
+	if m != nil {
+		return m.AssertionMethod
+	}
+	return nil
+}
+
+func (m *DidDiff) GetCapabilityInvocation() *DidListDiff {
+	if m != nil {
+		return m.CapabilityInvocation
+	}
+	return nil
+}
+
+func (m *DidDiff) GetCapabilityDelegation() *DidListDiff {
+	if m != nil {
+		return m.CapabilityDelegation
+	}
+	return nil
+}
+
+func (m *DidDiff) GetKeyAgreement() *DidListDiff {
+	if m != nil {
+		return m.KeyAgreement
+	}
+	return nil
+}
+
+func (m *DidDiff) GetService() *DidEntryDiff {
+	if m != nil {
+		return m.Service
+	}
+	return nil
+}
+
+func (m *DidDiff) GetAlsoKnownAs() *DidListDiff {
+	if m != nil {
+		return m.AlsoKnownAs
+	}
+	return nil
+}
+
+// DidListDiff is the change of a list of strings
+type DidListDiff struct {
+	Added   []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *DidListDiff) Reset()         { *m = DidListDiff{} }
+func (m *DidListDiff) String() string { return proto.CompactTextString(m) }
+func (*DidListDiff) ProtoMessage()    {}
+func (*DidListDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f455d02d8d4dc5, []int{1}
+}
+func (m *DidListDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidListDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidListDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidListDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidListDiff.Merge(m, src)
+}
+func (m *DidListDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidListDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidListDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidListDiff proto.InternalMessageInfo
+
+func (m *DidListDiff) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *DidListDiff) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+// DidEntryDiff is the change of a list of entries identified by their ids
+type DidEntryDiff struct {
+	Added   []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	Removed []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	Changed []string `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+}
+
+func (m *DidEntryDiff) Reset()         { *m = DidEntryDiff{} }
+func (m *DidEntryDiff) String() string { return proto.CompactTextString(m) }
+func (*DidEntryDiff) ProtoMessage()    {}
+func (*DidEntryDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8f455d02d8d4dc5, []int{2}
+}
+func (m *DidEntryDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidEntryDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidEntryDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidEntryDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidEntryDiff.Merge(m, src)
+}
+func (m *DidEntryDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidEntryDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidEntryDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidEntryDiff proto.InternalMessageInfo
+
+func (m *DidEntryDiff) GetAdded() []string {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *DidEntryDiff) GetRemoved() []string {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+func (m *DidEntryDiff) GetChanged() []string {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DidDiff)(nil), "cheqdid.cheqdnode.cheqd.v1.DidDiff")
+	proto.RegisterType((*DidListDiff)(nil), "cheqdid.cheqdnode.cheqd.v1.DidListDiff")
+	proto.RegisterType((*DidEntryDiff)(nil), "cheqdid.cheqdnode.cheqd.v1.DidEntryDiff")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/did_diff.proto", fileDescriptor_d8f455d02d8d4dc5) }
+
+var fileDescriptor_d8f455d02d8d4dc5 = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc6, 0xe3, 0xf4, 0xdf, 0xfa, 0x9f, 0x49, 0xd2, 0xa2, 0xa5, 0x88, 0x15, 0x07, 0xab, 0xca,
+	0x01, 0xc2, 0x01, 0x47, 0x85, 0x33, 0x87, 0x94, 0x20, 0x54, 0xb5, 0x08, 0x29, 0x07, 0x04, 0x08,
+	0xc9, 0xda, 0x78, 0xc7, 0xc9, 0x2a, 0xc9, 0x6e, 0x58, 0x6f, 0x4d, 0xfd, 0x0e, 0x1c, 0x78, 0x2c,
+	0x8e, 0x3d, 0x72, 0x44, 0xc9, 0x8b, 0xa0, 0x5d, 0x3b, 0xa9, 0x55, 0x09, 0x21, 0x73, 0xb1, 0x77,
+	0xe6, 0x9b, 0xef, 0xa7, 0xcf, 0xb2, 0x66, 0xe1, 0x61, 0x3c, 0xc3, 0x2f, 0x7c, 0x90, 0x9d, 0x0e,
+	0xb8, 0xe0, 0x11, 0x17, 0x49, 0x12, 0xae, 0xb4, 0x32, 0x8a, 0x3c, 0x72, 0x82, 0xe0, 0xa1, 0x7b,
+	0x4b, 0xc5, 0xb1, 0x38, 0x85, 0xd9, 0x69, 0xef, 0x9b, 0x0f, 0xfe, 0x48, 0xf0, 0x91, 0x48, 0x12,
+	0x72, 0x08, 0x4d, 0xc1, 0xa9, 0x77, 0xe2, 0xf5, 0x5b, 0xe3, 0xa6, 0xe0, 0xe4, 0x31, 0x1c, 0x25,
+	0x5a, 0x2d, 0xa3, 0x0c, 0x75, 0x2a, 0x94, 0x8c, 0x04, 0xa7, 0x4d, 0x27, 0x76, 0x6d, 0xfb, 0x7d,
+	0xd1, 0x3d, 0xe7, 0xa4, 0x07, 0x5d, 0xa3, 0xaa, 0x53, 0x7b, 0x6e, 0xaa, 0x6d, 0xd4, 0xed, 0xcc,
+	0x10, 0xfc, 0x58, 0x49, 0x83, 0xd7, 0x86, 0xfe, 0x77, 0xe2, 0xf5, 0xdb, 0xcf, 0x9f, 0x84, 0x7f,
+	0x4e, 0x15, 0x8e, 0x04, 0xbf, 0x14, 0xa9, 0xb1, 0xa9, 0xc6, 0x5b, 0x1f, 0x79, 0x03, 0x60, 0x8f,
+	0x5a, 0x2d, 0x16, 0xa8, 0xe9, 0x7e, 0x3d, 0x4a, 0xc5, 0x4a, 0x3e, 0xc2, 0xfd, 0x0c, 0xb5, 0x48,
+	0x44, 0xcc, 0x8c, 0x4d, 0xbc, 0x44, 0x33, 0x53, 0x9c, 0x1e, 0x38, 0x62, 0xff, 0x2f, 0xc4, 0xd7,
+	0xd2, 0xe8, 0xdc, 0x21, 0x49, 0x15, 0xf2, 0xd6, 0x31, 0xc8, 0x3b, 0x38, 0x64, 0x57, 0x66, 0x86,
+	0xd2, 0x94, 0x7d, 0xea, 0xd7, 0xcb, 0x79, 0xc7, 0x4e, 0xc6, 0x70, 0x8f, 0xa5, 0x29, 0xea, 0x6a,
+	0xd0, 0xff, 0xeb, 0x21, 0x8f, 0x76, 0x80, 0x32, 0xe4, 0x67, 0x78, 0x10, 0xb3, 0x15, 0x9b, 0x88,
+	0x85, 0x30, 0x79, 0x24, 0x64, 0xa6, 0xca, 0xac, 0xad, 0x7a, 0xe0, 0xe3, 0x5b, 0xca, 0xf9, 0x0e,
+	0x72, 0x87, 0xce, 0x71, 0x81, 0xd3, 0x82, 0x0e, 0xff, 0x4c, 0x1f, 0xed, 0x20, 0xe4, 0x12, 0xba,
+	0x73, 0xcc, 0x23, 0x36, 0xd5, 0x88, 0x4b, 0x94, 0x86, 0xb6, 0xeb, 0x51, 0x3b, 0x73, 0xcc, 0x87,
+	0x5b, 0x33, 0x39, 0x03, 0x3f, 0x45, 0x9d, 0x89, 0x18, 0x69, 0xa7, 0xe6, 0xdf, 0xdf, 0x1a, 0xc9,
+	0x05, 0x74, 0xd9, 0x22, 0x55, 0xd1, 0x5c, 0xaa, 0xaf, 0x32, 0x62, 0x29, 0xed, 0xd6, 0x4b, 0xd4,
+	0xb6, 0xee, 0x0b, 0x6b, 0x1e, 0xa6, 0xbd, 0x97, 0xd0, 0xae, 0x68, 0xe4, 0x18, 0xf6, 0x19, 0xe7,
+	0x68, 0x97, 0x72, 0xaf, 0xdf, 0x1a, 0x17, 0x05, 0xa1, 0xe0, 0x6b, 0x5c, 0xaa, 0x0c, 0xed, 0x3e,
+	0xda, 0xfe, 0xb6, 0xec, 0x7d, 0x80, 0x4e, 0x35, 0x64, 0x5d, 0xbf, 0x55, 0xe2, 0x19, 0x93, 0x53,
+	0xb4, 0x3b, 0xec, 0x94, 0xb2, 0x3c, 0x7b, 0xf5, 0x63, 0x1d, 0x78, 0x37, 0xeb, 0xc0, 0xfb, 0xb5,
+	0x0e, 0xbc, 0xef, 0x9b, 0xa0, 0x71, 0xb3, 0x09, 0x1a, 0x3f, 0x37, 0x41, 0xe3, 0xd3, 0xd3, 0xa9,
+	0x30, 0xb3, 0xab, 0x49, 0x18, 0xab, 0xe5, 0xa0, 0xb8, 0x81, 0xdc, 0xf3, 0x99, 0xfd, 0xe2, 0xc1,
+	0x75, 0xd9, 0x32, 0xf9, 0x0a, 0xd3, 0xc9, 0x81, 0xbb, 0x8f, 0x5e, 0xfc, 0x1e, 0x00, 0xd1, 0xbc,
+	0x6a, 0xeb, 0xaa, 0x04, 0x00, 0x00,
+}
+
+func (m *DidDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AlsoKnownAs != nil {
+		{
+			size, err := m.AlsoKnownAs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.KeyAgreement != nil {
+		{
+			size, err := m.KeyAgreement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CapabilityDelegation != nil {
+		{
+			size, err := m.CapabilityDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CapabilityInvocation != nil {
+		{
+			size, err := m.CapabilityInvocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AssertionMethod != nil {
+		{
+			size, err := m.AssertionMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Authentication != nil {
+		{
+			size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VerificationMethod != nil {
+		{
+			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Controller != nil {
+		{
+			size, err := m.Controller.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidDiff(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ToVersionId) > 0 {
+		i -= len(m.ToVersionId)
+		copy(dAtA[i:], m.ToVersionId)
+		i = encodeVarintDidDiff(dAtA, i, uint64(len(m.ToVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromVersionId) > 0 {
+		i -= len(m.FromVersionId)
+		copy(dAtA[i:], m.FromVersionId)
+		i = encodeVarintDidDiff(dAtA, i, uint64(len(m.FromVersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidListDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidListDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidListDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidEntryDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidEntryDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidEntryDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changed) > 0 {
+		for iNdEx := len(m.Changed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Changed[iNdEx])
+			copy(dAtA[i:], m.Changed[iNdEx])
+			i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Changed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Removed[iNdEx])
+			copy(dAtA[i:], m.Removed[iNdEx])
+			i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Removed[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Added[iNdEx])
+			copy(dAtA[i:], m.Added[iNdEx])
+			i = encodeVarintDidDiff(dAtA, i, uint64(len(m.Added[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDidDiff(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDidDiff(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DidDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	l = len(m.FromVersionId)
+	if l > 0 {
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	l = len(m.ToVersionId)
+	if l > 0 {
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.Controller != nil {
+		l = m.Controller.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.VerificationMethod != nil {
+		l = m.VerificationMethod.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.Authentication != nil {
+		l = m.Authentication.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.AssertionMethod != nil {
+		l = m.AssertionMethod.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.CapabilityInvocation != nil {
+		l = m.CapabilityInvocation.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.CapabilityDelegation != nil {
+		l = m.CapabilityDelegation.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.KeyAgreement != nil {
+		l = m.KeyAgreement.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	if m.AlsoKnownAs != nil {
+		l = m.AlsoKnownAs.Size()
+		n += 1 + l + sovDidDiff(uint64(l))
+	}
+	return n
+}
+
+func (m *DidListDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovDidDiff(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovDidDiff(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DidEntryDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, s := range m.Added {
+			l = len(s)
+			n += 1 + l + sovDidDiff(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovDidDiff(uint64(l))
+		}
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			l = len(s)
+			n += 1 + l + sovDidDiff(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDidDiff(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDidDiff(x uint64) (n int) {
+	return sovDidDiff(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DidDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDidDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &DidListDiff{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Controller == nil {
+				m.Controller = &DidListDiff{}
+			}
+			if err := m.Controller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationMethod == nil {
+				m.VerificationMethod = &DidEntryDiff{}
+			}
+			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authentication == nil {
+				m.Authentication = &DidListDiff{}
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssertionMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AssertionMethod == nil {
+				m.AssertionMethod = &DidListDiff{}
+			}
+			if err := m.AssertionMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CapabilityInvocation == nil {
+				m.CapabilityInvocation = &DidListDiff{}
+			}
+			if err := m.CapabilityInvocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CapabilityDelegation == nil {
+				m.CapabilityDelegation = &DidListDiff{}
+			}
+			if err := m.CapabilityDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyAgreement == nil {
+				m.KeyAgreement = &DidListDiff{}
+			}
+			if err := m.KeyAgreement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &DidEntryDiff{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlsoKnownAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AlsoKnownAs == nil {
+				m.AlsoKnownAs = &DidListDiff{}
+			}
+			if err := m.AlsoKnownAs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDidDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidListDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDidDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidListDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidListDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDidDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidEntryDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDidDiff
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidEntryDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidEntryDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDidDiff(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDidDiff
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDidDiff(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDidDiff
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDidDiff
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDidDiff
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDidDiff
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDidDiff
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDidDiff        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDidDiff          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDidDiff = fmt.Errorf("proto: unexpected end of group")
+)
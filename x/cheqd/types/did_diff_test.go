@@ -0,0 +1,130 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDidDiff(t *testing.T) {
+	vm := func(fragment string, key string) *VerificationMethod {
+		return &VerificationMethod{
+			Id:                 ValidTestDID + "#" + fragment,
+			Type:               "Ed25519VerificationKey2020",
+			Controller:         ValidTestDID,
+			PublicKeyMultibase: key,
+		}
+	}
+
+	from := Did{
+		Id:                 ValidTestDID,
+		Controller:         []string{ValidTestDID},
+		VerificationMethod: []*VerificationMethod{vm("key-1", ValidEd25519PubKey), vm("key-2", ValidEd25519PubKey)},
+		Authentication:     []string{ValidTestDID + "#key-1"},
+		Service: []*Service{
+			{Id: ValidTestDID + "#service-1", Type: "DIDCommMessaging", ServiceEndpoint: "endpoint"},
+			{Id: ValidTestDID + "#service-2", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		update   func(did *Did)
+		expected DidDiff
+	}{
+		{
+			name:     "No changes",
+			update:   func(did *Did) {},
+			expected: DidDiff{Id: ValidTestDID, FromVersionId: "v1", ToVersionId: "v2"},
+		},
+		{
+			name: "Controllers changed",
+			update: func(did *Did) {
+				did.Controller = []string{ValidTestDID2}
+			},
+			expected: DidDiff{
+				Id: ValidTestDID, FromVersionId: "v1", ToVersionId: "v2",
+				Controller: &DidListDiff{Added: []string{ValidTestDID2}, Removed: []string{ValidTestDID}},
+			},
+		},
+		{
+			name: "Keys rotated, added and removed",
+			update: func(did *Did) {
+				did.VerificationMethod = []*VerificationMethod{vm("key-1", "zNewKey"), vm("key-3", ValidEd25519PubKey)}
+			},
+			expected: DidDiff{
+				Id: ValidTestDID, FromVersionId: "v1", ToVersionId: "v2",
+				VerificationMethod: &DidEntryDiff{
+					Added:   []string{ValidTestDID + "#key-3"},
+					Removed: []string{ValidTestDID + "#key-2"},
+					Changed: []string{ValidTestDID + "#key-1"},
+				},
+			},
+		},
+		{
+			name: "Service edited",
+			update: func(did *Did) {
+				did.Service = []*Service{
+					{Id: ValidTestDID + "#service-1", Type: "DIDCommMessaging", ServiceEndpoint: "new-endpoint"},
+					from.Service[1],
+				}
+			},
+			expected: DidDiff{
+				Id: ValidTestDID, FromVersionId: "v1", ToVersionId: "v2",
+				Service: &DidEntryDiff{Changed: []string{ValidTestDID + "#service-1"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			to := from
+			tc.update(&to)
+
+			diff := NewDidDiff(from, "v1", to, "v2")
+			require.Equal(t, tc.expected, diff)
+			require.Equal(t, tc.name == "No changes", diff.IsEmpty())
+		})
+	}
+}
+
+func TestDidDiffChangedFields(t *testing.T) {
+	existing := Did{
+		Id:             ValidTestDID,
+		Controller:     []string{ValidTestDID},
+		Authentication: []string{ValidTestDID + "#key-1"},
+		Service: []*Service{
+			{Id: ValidTestDID + "#service-1", Type: "DIDCommMessaging", ServiceEndpoint: "endpoint"},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		update   func(did *Did)
+		expected []string
+	}{
+		{"Nothing changed", func(did *Did) {}, nil},
+		{"Nil and empty lists are equal", func(did *Did) { did.AlsoKnownAs = []string{} }, nil},
+		{"Controller added", func(did *Did) { did.Controller = append(did.Controller, ValidTestDID2) }, []string{PatchFieldController}},
+		{
+			name: "Service edited and authentication removed",
+			update: func(did *Did) {
+				did.Authentication = nil
+				did.Service = []*Service{
+					{Id: ValidTestDID + "#service-1", Type: "DIDCommMessaging", ServiceEndpoint: "new-endpoint"},
+				}
+			},
+			expected: []string{PatchFieldAuthentication, PatchFieldService},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := existing
+			updated.Controller = append([]string{}, existing.Controller...)
+			tc.update(&updated)
+
+			require.Equal(t, tc.expected, NewDidDiff(existing, "v1", updated, "v2").ChangedFields())
+		})
+	}
+}
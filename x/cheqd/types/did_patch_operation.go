@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	PatchOpAdd    = "add"
+	PatchOpRemove = "remove"
+)
+
+const (
+	PatchFieldContext              = "context"
+	PatchFieldController           = "controller"
+	PatchFieldVerificationMethod   = "verificationMethod"
+	PatchFieldAuthentication       = "authentication"
+	PatchFieldAssertionMethod      = "assertionMethod"
+	PatchFieldCapabilityInvocation = "capabilityInvocation"
+	PatchFieldCapabilityDelegation = "capabilityDelegation"
+	PatchFieldKeyAgreement         = "keyAgreement"
+	PatchFieldService              = "service"
+	PatchFieldAlsoKnownAs          = "alsoKnownAs"
+)
+
+var SupportedPatchOps = []string{
+	PatchOpAdd,
+	PatchOpRemove,
+}
+
+var SupportedPatchFields = []string{
+	PatchFieldContext,
+	PatchFieldController,
+	PatchFieldVerificationMethod,
+	PatchFieldAuthentication,
+	PatchFieldAssertionMethod,
+	PatchFieldCapabilityInvocation,
+	PatchFieldCapabilityDelegation,
+	PatchFieldKeyAgreement,
+	PatchFieldService,
+	PatchFieldAlsoKnownAs,
+}
+
+func NewDidPatchOperation(op string, field string, value string, verificationMethod *VerificationMethod, service *Service) *DidPatchOperation {
+	return &DidPatchOperation{
+		Op:                 op,
+		Field:              field,
+		Value:              value,
+		VerificationMethod: verificationMethod,
+		Service:            service,
+	}
+}
+
+// Helpers
+
+// Apply modifies the passed did according to the operation.
+// Full validation of the result is expected to be done by the caller using Did.Validate.
+func (op DidPatchOperation) Apply(did *Did) error {
+	switch op.Field {
+	case PatchFieldVerificationMethod:
+		if op.Op == PatchOpAdd {
+			vm := *op.VerificationMethod
+			did.VerificationMethod = append(did.VerificationMethod, &vm)
+			return nil
+		}
+
+		for i, vm := range did.VerificationMethod {
+			if vm.Id == op.Value {
+				did.VerificationMethod = append(did.VerificationMethod[:i], did.VerificationMethod[i+1:]...)
+				return nil
+			}
+		}
+
+		return fmt.Errorf("verification method not found: %s", op.Value)
+
+	case PatchFieldService:
+		if op.Op == PatchOpAdd {
+			service := *op.Service
+			did.Service = append(did.Service, &service)
+			return nil
+		}
+
+		for i, service := range did.Service {
+			if service.Id == op.Value {
+				did.Service = append(did.Service[:i], did.Service[i+1:]...)
+				return nil
+			}
+		}
+
+		return fmt.Errorf("service not found: %s", op.Value)
+	}
+
+	list := did.getStringListField(op.Field)
+
+	if op.Op == PatchOpAdd {
+		*list = append(*list, op.Value)
+		return nil
+	}
+
+	index := utils.IndexOf(*list, op.Value, 0)
+	if index < 0 {
+		return fmt.Errorf("%s entry not found: %s", op.Field, op.Value)
+	}
+
+	*list = append((*list)[:index], (*list)[index+1:]...)
+	return nil
+}
+
+func (did *Did) getStringListField(field string) *[]string {
+	switch field {
+	case PatchFieldContext:
+		return &did.Context
+	case PatchFieldController:
+		return &did.Controller
+	case PatchFieldAuthentication:
+		return &did.Authentication
+	case PatchFieldAssertionMethod:
+		return &did.AssertionMethod
+	case PatchFieldCapabilityInvocation:
+		return &did.CapabilityInvocation
+	case PatchFieldCapabilityDelegation:
+		return &did.CapabilityDelegation
+	case PatchFieldKeyAgreement:
+		return &did.KeyAgreement
+	case PatchFieldAlsoKnownAs:
+		return &did.AlsoKnownAs
+	default:
+		panic(fmt.Sprintf("unsupported patch field: %s", field)) // This should have been checked during basic validation
+	}
+}
+
+// Validation
+
+func (op DidPatchOperation) Validate() error {
+	// Verification methods and services are passed as objects only when they are added
+	addsObject := op.Op == PatchOpAdd && (op.Field == PatchFieldVerificationMethod || op.Field == PatchFieldService)
+
+	return validation.ValidateStruct(&op,
+		validation.Field(&op.Op, validation.Required, validation.In(utils.ToInterfaces(SupportedPatchOps)...)),
+		validation.Field(&op.Field, validation.Required, validation.In(utils.ToInterfaces(SupportedPatchFields)...)),
+		validation.Field(&op.Value, validation.When(addsObject, validation.Empty).Else(validation.Required)),
+		validation.Field(&op.VerificationMethod,
+			validation.When(addsObject && op.Field == PatchFieldVerificationMethod, validation.Required).Else(validation.Nil),
+		),
+		validation.Field(&op.Service,
+			validation.When(addsObject && op.Field == PatchFieldService, validation.Required).Else(validation.Nil),
+		),
+	)
+}
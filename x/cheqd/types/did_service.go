@@ -7,6 +7,7 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+// SupportedServiceTypes are allowed by default, governance can allow more types with AllowedServiceTypes param
 var SupportedServiceTypes = []string{
 	"LinkedDomains",
 	"DIDCommMessaging",
@@ -37,7 +38,7 @@ func GetServiceIds(vms []*Service) []string {
 func (s Service) Validate(baseDid string, allowedNamespaces []string) error {
 	return validation.ValidateStruct(&s,
 		validation.Field(&s.Id, validation.Required, IsDIDUrl(allowedNamespaces, Empty, Empty, Required), HasPrefix(baseDid)),
-		validation.Field(&s.Type, validation.Required, validation.In(utils.ToInterfaces(SupportedServiceTypes)...)),
+		validation.Field(&s.Type, validation.Required, validation.Length(0, MaxTypeNameLength)),
 		validation.Field(&s.ServiceEndpoint, validation.Required),
 	)
 }
@@ -3,14 +3,15 @@ package types
 import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"reflect"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
-	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/multiformats/go-multibase"
 )
 
@@ -32,6 +33,14 @@ var MultibaseMethodTypes = []string{
 	Ed25519VerificationKey2020,
 }
 
+var SupportedJWSAlgs = []string{
+	utils.JWSAlgEdDSA,
+	utils.JWSAlgRS256,
+	utils.JWSAlgPS256,
+	utils.JWSAlgES256,
+	utils.JWSAlgES256K,
+}
+
 func NewVerificationMethod(id string, type_ string, controller string, publicKeyJwk []*KeyValuePair, publicKeyMultibase string) *VerificationMethod {
 	return &VerificationMethod{
 		Id:                 id,
@@ -64,43 +73,97 @@ func GetVerificationMethodIds(vms []*VerificationMethod) []string {
 	return res
 }
 
-func VerifySignature(vm VerificationMethod, message []byte, signature []byte) error {
-	var verificationError error
-
+// GetPublicKey returns the raw public key: ed25519.PublicKey, *rsa.PublicKey or *ecdsa.PublicKey
+func (vm VerificationMethod) GetPublicKey() (interface{}, error) {
 	switch vm.Type {
 	case Ed25519VerificationKey2020:
 		_, keyBytes, err := multibase.Decode(vm.PublicKeyMultibase)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		verificationError = utils.VerifyED25519Signature(keyBytes, message, signature)
+		return ed25519.PublicKey(keyBytes), nil
 
 	case JsonWebKey2020:
 		keyJson, err := PubKeyJWKToJson(vm.PublicKeyJwk)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		var raw interface{}
-		err = jwk.ParseRawKey([]byte(keyJson), &raw)
-		if err != nil {
-			return fmt.Errorf("can't parse jwk: %s", err.Error())
-		}
+		return utils.ParseJWK(keyJson)
 
-		switch pubKey := raw.(type) {
-		case *rsa.PublicKey:
-			verificationError = utils.VerifyRSASignature(*pubKey, message, signature)
-		case *ecdsa.PublicKey:
-			verificationError = utils.VerifyECDSASignature(*pubKey, message, signature)
-		case ed25519.PublicKey:
-			verificationError = utils.VerifyED25519Signature(pubKey, message, signature)
-		default:
-			panic("unsupported jwk key") // This should have been checked during basic validation
+	default:
+		panic("unsupported verification method type") // This should have also been checked during basic validation
+	}
+}
+
+func VerifySignature(vm VerificationMethod, message []byte, signature []byte) error {
+	var verificationError error
+
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return err
+	}
+
+	switch pubKey := pubKey.(type) {
+	case *rsa.PublicKey:
+		verificationError = utils.VerifyRSASignature(*pubKey, message, signature)
+	case *ecdsa.PublicKey:
+		verificationError = utils.VerifyECDSASignature(*pubKey, message, signature)
+	case ed25519.PublicKey:
+		verificationError = utils.VerifyED25519Signature(pubKey, message, signature)
+	default:
+		panic("unsupported jwk key") // This should have been checked during basic validation
+	}
+
+	if verificationError != nil {
+		return ErrInvalidSignature.Wrapf("verification method: %s, err: %s", vm.Id, verificationError.Error())
+	}
+
+	return nil
+}
+
+// VerifyJWSSignature verifies detached JWS. The algorithm from the header must match the verification method key.
+func VerifyJWSSignature(vm VerificationMethod, message []byte, signature string) error {
+	jws, err := utils.ParseDetachedJWS(signature)
+	if err != nil {
+		return ErrInvalidSignature.Wrapf("verification method: %s, err: %s", vm.Id, err.Error())
+	}
+
+	if jws.Header.Kid != vm.Id {
+		return ErrInvalidSignature.Wrapf("verification method: %s, err: jws kid doesn't match: %s", vm.Id, jws.Header.Kid)
+	}
+
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return err
+	}
+
+	alg := jws.Header.Alg
+	signingInput := jws.SigningInput(message)
+	verificationError := fmt.Errorf("jws alg %s can't be used with the verification method key", alg)
+
+	switch pubKey := pubKey.(type) {
+	case ed25519.PublicKey:
+		if alg == utils.JWSAlgEdDSA {
+			verificationError = utils.VerifyED25519Signature(pubKey, signingInput, jws.Signature)
+		}
+	case *rsa.PublicKey:
+		switch alg {
+		case utils.JWSAlgRS256:
+			verificationError = utils.VerifyRSAPKCS1v15Signature(*pubKey, signingInput, jws.Signature)
+		case utils.JWSAlgPS256:
+			verificationError = utils.VerifyRSASignature(*pubKey, signingInput, jws.Signature)
 		}
+	case *ecdsa.PublicKey:
+		secp256k1 := utils.IsSecp256k1PubKey(*pubKey)
+		p256 := pubKey.Curve == elliptic.P256()
 
+		if (alg == utils.JWSAlgES256K && secp256k1) || (alg == utils.JWSAlgES256 && p256) {
+			verificationError = utils.VerifyECDSARawSignature(*pubKey, signingInput, jws.Signature)
+		}
 	default:
-		panic("unsupported verification method type") // This should have also been checked during basic validation
+		panic("unsupported jwk key") // This should have been checked during basic validation
 	}
 
 	if verificationError != nil {
@@ -110,6 +173,25 @@ func VerifySignature(vm VerificationMethod, message []byte, signature []byte) er
 	return nil
 }
 
+// VerifySignInfo verifies the signature according to its format
+func VerifySignInfo(vm VerificationMethod, message []byte, signInfo SignInfo) error {
+	switch signInfo.SignatureFormat {
+	case SignatureFormat_SIGNATURE_FORMAT_RAW:
+		signatureBytes, err := base64.StdEncoding.DecodeString(signInfo.Signature)
+		if err != nil {
+			return err
+		}
+
+		return VerifySignature(vm, message, signatureBytes)
+
+	case SignatureFormat_SIGNATURE_FORMAT_JWS:
+		return VerifyJWSSignature(vm, message, signInfo.Signature)
+
+	default:
+		return ErrInvalidSignature.Wrapf("unsupported signature format: %s", signInfo.SignatureFormat)
+	}
+}
+
 func VerificationMethodListToMapByFragment(vms []*VerificationMethod) map[string]VerificationMethod {
 	result := map[string]VerificationMethod{}
 
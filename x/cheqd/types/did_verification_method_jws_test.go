@@ -0,0 +1,235 @@
+package types
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/multiformats/go-multibase"
+	"github.com/stretchr/testify/require"
+)
+
+const jwsTestKid = "did:cheqd:testnet:123456789abcdefg#key1"
+
+func jwkVerificationMethod(t *testing.T, pubKey interface{}) VerificationMethod {
+	jwk_, err := jwk.New(pubKey)
+	require.NoError(t, err)
+	json_, err := json.Marshal(jwk_)
+	require.NoError(t, err)
+
+	return VerificationMethod{
+		Id:           jwsTestKid,
+		Type:         JsonWebKey2020,
+		PublicKeyJwk: JSONToPubKeyJWK(string(json_)),
+	}
+}
+
+// signDetachedJWS uses a standard JOSE library and strips the payload from the compact serialization
+func signDetachedJWS(t *testing.T, message []byte, alg jwa.SignatureAlgorithm, privKey interface{}, kid string) string {
+	headers := jws.NewHeaders()
+	require.NoError(t, headers.Set(jws.KeyIDKey, kid))
+
+	signed, err := jws.Sign(message, alg, privKey, jws.WithHeaders(headers))
+	require.NoError(t, err)
+
+	parts := strings.Split(string(signed), ".")
+	return parts[0] + ".." + parts[2]
+}
+
+func signDetachedES256K(t *testing.T, message []byte, privKey *btcec.PrivateKey, kid string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"ES256K","kid":"%s"}`, kid)))
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(message)
+
+	hasher := crypto.SHA256.New()
+	hasher.Write([]byte(signingInput))
+	signature, err := privKey.Sign(hasher.Sum(nil))
+	require.NoError(t, err)
+
+	raw := make([]byte, 64)
+	signature.R.FillBytes(raw[:32])
+	signature.S.FillBytes(raw[32:])
+
+	return header + ".." + base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func TestJWSSignatureVerification(t *testing.T) {
+	message := []byte("Lorem ipsum dolor sit amet, consectetur adipiscing elit")
+
+	edPubKey, edPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edPubKeyStr, err := multibase.Encode(multibase.Base58BTC, edPubKey)
+	require.NoError(t, err)
+
+	edMultibaseVM := VerificationMethod{
+		Id:                 jwsTestKid,
+		Type:               Ed25519VerificationKey2020,
+		PublicKeyMultibase: edPubKeyStr,
+	}
+
+	rsaPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p256PrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	secpPrivKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	secpPrivKey.PublicKey.X.FillBytes(x)
+	secpPrivKey.PublicKey.Y.FillBytes(y)
+	secpVM := VerificationMethod{
+		Id:   jwsTestKid,
+		Type: JsonWebKey2020,
+		PublicKeyJwk: JSONToPubKeyJWK(fmt.Sprintf(`{"kty":"EC","crv":"secp256k1","x":"%s","y":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(x), base64.RawURLEncoding.EncodeToString(y))),
+	}
+
+	cases := []struct {
+		name     string
+		vm       VerificationMethod
+		jws      string
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: EdDSA with Ed25519VerificationKey2020",
+			vm:      edMultibaseVM,
+			jws:     signDetachedJWS(t, message, jwa.EdDSA, edPrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:    "positive: EdDSA with JWK",
+			vm:      jwkVerificationMethod(t, edPubKey),
+			jws:     signDetachedJWS(t, message, jwa.EdDSA, edPrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:    "positive: RS256",
+			vm:      jwkVerificationMethod(t, rsaPrivKey.PublicKey),
+			jws:     signDetachedJWS(t, message, jwa.RS256, rsaPrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:    "positive: PS256",
+			vm:      jwkVerificationMethod(t, rsaPrivKey.PublicKey),
+			jws:     signDetachedJWS(t, message, jwa.PS256, rsaPrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:    "positive: ES256",
+			vm:      jwkVerificationMethod(t, p256PrivKey.PublicKey),
+			jws:     signDetachedJWS(t, message, jwa.ES256, p256PrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:    "positive: ES256K",
+			vm:      secpVM,
+			jws:     signDetachedES256K(t, message, secpPrivKey, jwsTestKid),
+			isValid: true,
+		},
+		{
+			name:     "negative: alg doesn't match the key",
+			vm:       jwkVerificationMethod(t, p256PrivKey.PublicKey),
+			jws:      signDetachedJWS(t, message, jwa.ES384, p256PrivKey, jwsTestKid),
+			isValid:  false,
+			errorMsg: "jws alg ES384 can't be used with the verification method key",
+		},
+		{
+			name:     "negative: ES256K with P-256 key",
+			vm:       jwkVerificationMethod(t, p256PrivKey.PublicKey),
+			jws:      signDetachedES256K(t, message, secpPrivKey, jwsTestKid),
+			isValid:  false,
+			errorMsg: "jws alg ES256K can't be used with the verification method key",
+		},
+		{
+			name:     "negative: kid doesn't match",
+			vm:       edMultibaseVM,
+			jws:      signDetachedJWS(t, message, jwa.EdDSA, edPrivKey, "did:cheqd:testnet:123456789abcdefg#key2"),
+			isValid:  false,
+			errorMsg: "jws kid doesn't match",
+		},
+		{
+			name:     "negative: wrong key",
+			vm:       jwkVerificationMethod(t, rsaPrivKey.PublicKey),
+			jws:      signDetachedJWS(t, message, jwa.EdDSA, edPrivKey, jwsTestKid),
+			isValid:  false,
+			errorMsg: "jws alg EdDSA can't be used with the verification method key",
+		},
+		{
+			name:     "negative: payload is not detached",
+			vm:       edMultibaseVM,
+			jws:      strings.Replace(signDetachedJWS(t, message, jwa.EdDSA, edPrivKey, jwsTestKid), "..", ".e30.", 1),
+			isValid:  false,
+			errorMsg: "jws payload must be detached",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			signInfo := SignInfo{
+				VerificationMethodId: jwsTestKid,
+				Signature:            tc.jws,
+				SignatureFormat:      SignatureFormat_SIGNATURE_FORMAT_JWS,
+			}
+
+			err := VerifySignInfo(tc.vm, message, signInfo)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestSecp256k1KeysAreSupportedOnlyForJWS(t *testing.T) {
+	message := []byte("message")
+
+	secpPrivKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	secpPrivKey.PublicKey.X.FillBytes(x)
+	secpPrivKey.PublicKey.Y.FillBytes(y)
+	secpVM := VerificationMethod{
+		Id:   jwsTestKid,
+		Type: JsonWebKey2020,
+		PublicKeyJwk: JSONToPubKeyJWK(fmt.Sprintf(`{"kty":"EC","crv":"secp256k1","x":"%s","y":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(x), base64.RawURLEncoding.EncodeToString(y))),
+	}
+
+	digest := crypto.SHA256.New()
+	digest.Write(message)
+	derSignature, err := secpPrivKey.Sign(digest.Sum(nil))
+	require.NoError(t, err)
+
+	err = VerifySignInfo(secpVM, message, SignInfo{
+		VerificationMethodId: jwsTestKid,
+		Signature:            base64.StdEncoding.EncodeToString(derSignature.Serialize()),
+		SignatureFormat:      SignatureFormat_SIGNATURE_FORMAT_RAW,
+	})
+	require.ErrorIs(t, err, ErrInvalidSignature)
+	require.Contains(t, err.Error(), "secp256k1 keys can be used only with jws signatures")
+
+	err = VerifySignInfo(secpVM, message, SignInfo{
+		VerificationMethodId: jwsTestKid,
+		Signature:            signDetachedES256K(t, message, secpPrivKey, jwsTestKid),
+		SignatureFormat:      SignatureFormat_SIGNATURE_FORMAT_JWS,
+	})
+	require.NoError(t, err)
+}
@@ -11,12 +11,46 @@ var (
 	ErrBadRequest                 = sdkerrors.Register(ModuleName, 1000, "bad request")
 	ErrInvalidSignature           = sdkerrors.Register(ModuleName, 1100, "invalid signature detected")
 	ErrSignatureNotFound          = sdkerrors.Register(ModuleName, 1101, "signature is required but not found")
+	ErrSignatureExpired           = sdkerrors.Register(ModuleName, 1102, "signature expired")
+	ErrLegacySignature            = sdkerrors.Register(ModuleName, 1103, "legacy signatures are not accepted anymore")
 	ErrDidDocExists               = sdkerrors.Register(ModuleName, 1200, "DID Doc exists")
 	ErrDidDocNotFound             = sdkerrors.Register(ModuleName, 1201, "DID Doc not found")
 	ErrVerificationMethodNotFound = sdkerrors.Register(ModuleName, 1202, "verification method not found")
 	ErrUnexpectedDidVersion       = sdkerrors.Register(ModuleName, 1203, "unexpected DID version")
+	ErrInvalidPublicKey           = sdkerrors.Register(ModuleName, 1204, "invalid public key")
 	ErrBasicValidation            = sdkerrors.Register(ModuleName, 1205, "basic validation failed")
 	ErrNamespaceValidation        = sdkerrors.Register(ModuleName, 1206, "DID namespace validation failed")
+	ErrInvalidPatchOperation      = sdkerrors.Register(ModuleName, 1207, "invalid DID patch operation")
+	ErrParamsValidation           = sdkerrors.Register(ModuleName, 1208, "module params validation failed")
+	ErrDidDocDeactivated          = sdkerrors.Register(ModuleName, 1209, "DID Doc deactivated")
+	ErrTrustedIssuerNotFound      = sdkerrors.Register(ModuleName, 1210, "trusted issuer not found")
+	ErrDidReserved                = sdkerrors.Register(ModuleName, 1211, "DID is reserved")
+	ErrDidBlocked                 = sdkerrors.Register(ModuleName, 1212, "DID is blocked")
+	ErrReservedIdNotFound         = sdkerrors.Register(ModuleName, 1213, "reserved DID not found")
+	ErrInvalidSelfCertifyingId    = sdkerrors.Register(ModuleName, 1214, "unique id is not derived from the initial authentication key")
+	ErrSchemaExists               = sdkerrors.Register(ModuleName, 1400, "schema exists")
+	ErrSchemaNotFound             = sdkerrors.Register(ModuleName, 1401, "schema not found")
+	ErrCredDefExists              = sdkerrors.Register(ModuleName, 1402, "credential definition exists")
+	ErrCredDefNotFound            = sdkerrors.Register(ModuleName, 1403, "credential definition not found")
+	ErrRevocRegDefExists          = sdkerrors.Register(ModuleName, 1404, "revocation registry definition exists")
+	ErrRevocRegDefNotFound        = sdkerrors.Register(ModuleName, 1405, "revocation registry definition not found")
+	ErrRevocRegEntryExists        = sdkerrors.Register(ModuleName, 1406, "revocation registry entry exists")
+	ErrRevocRegEntryNotFound      = sdkerrors.Register(ModuleName, 1407, "revocation registry entry not found")
+	ErrUnexpectedRevocRegVersion  = sdkerrors.Register(ModuleName, 1408, "unexpected revocation registry version")
+	ErrStatusListExists           = sdkerrors.Register(ModuleName, 1409, "status list exists")
+	ErrStatusListNotFound         = sdkerrors.Register(ModuleName, 1410, "status list not found")
+	ErrStatusListIndexOutOfRange  = sdkerrors.Register(ModuleName, 1411, "status list index out of range")
+	ErrResourceExists             = sdkerrors.Register(ModuleName, 1412, "resource exists")
+	ErrResourceNotFound           = sdkerrors.Register(ModuleName, 1413, "resource not found")
+	ErrEscrowExists               = sdkerrors.Register(ModuleName, 1414, "escrow exists")
+	ErrEscrowNotFound             = sdkerrors.Register(ModuleName, 1415, "escrow not found")
+	ErrEscrowNotLocked            = sdkerrors.Register(ModuleName, 1416, "escrow is not locked")
+	ErrEscrowExpired              = sdkerrors.Register(ModuleName, 1417, "escrow expired")
+	ErrEscrowNotExpired           = sdkerrors.Register(ModuleName, 1418, "escrow not expired")
+	ErrDidVersionNotFound         = sdkerrors.Register(ModuleName, 1419, "did version not found")
+	ErrProposalNotFound           = sdkerrors.Register(ModuleName, 1420, "executing proposal not found")
+	ErrIdInUse                    = sdkerrors.Register(ModuleName, 1421, "id is used by another ledger object")
+	ErrStatusListVersionMismatch  = sdkerrors.Register(ModuleName, 1422, "unexpected status list version")
 	ErrUnpackStateValue           = sdkerrors.Register(ModuleName, 1300, "invalid did state value")
 	ErrInternal                   = sdkerrors.Register(ModuleName, 1500, "internal error")
 )
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// EscrowRequestIdMaxLength is the maximum length of a credential request id
+const EscrowRequestIdMaxLength = 128
+
+// Bounds of the payment timeout in seconds. The issuer must have time to claim the payment.
+const (
+	EscrowMinTimeout = 60 * 60
+	EscrowMaxTimeout = 365 * 24 * 60 * 60
+)
+
+func NewEscrow(requestId string, issuer string, holder string, amount sdk.Coin, expiresAt int64) Escrow {
+	return Escrow{
+		RequestId: requestId,
+		Issuer:    issuer,
+		Holder:    holder,
+		Amount:    &amount,
+		ExpiresAt: expiresAt,
+		Status:    EscrowStatus_ESCROW_STATUS_LOCKED,
+	}
+}
+
+// IsExpired returns true if the holder can reclaim the payment at the given block time
+func (escrow Escrow) IsExpired(blockTime time.Time) bool {
+	return blockTime.Unix() >= escrow.ExpiresAt
+}
+
+// GetEscrowExpiresAt returns the unix timestamp the payment locked at the block time expires at
+func GetEscrowExpiresAt(blockTime time.Time, timeout uint64) (int64, error) {
+	now := blockTime.Unix()
+	if timeout > math.MaxInt64 || now > math.MaxInt64-int64(timeout) {
+		return 0, fmt.Errorf("timeout %d overflows the block time %d", timeout, now)
+	}
+
+	return now + int64(timeout), nil
+}
+
+// Validation
+
+func (escrow Escrow) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&escrow,
+		validation.Field(&escrow.RequestId, validation.Required, validation.Length(1, EscrowRequestIdMaxLength)),
+		validation.Field(&escrow.Issuer, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&escrow.Holder, validation.Required, IsBech32Address()),
+		validation.Field(&escrow.Amount, ValidPaymentAmountRule()),
+		validation.Field(&escrow.Status, validation.Required, validation.In(
+			EscrowStatus_ESCROW_STATUS_LOCKED, EscrowStatus_ESCROW_STATUS_CLAIMED, EscrowStatus_ESCROW_STATUS_RECLAIMED,
+		)),
+		validation.Field(&escrow.Recipient,
+			validation.When(escrow.Status == EscrowStatus_ESCROW_STATUS_CLAIMED, validation.Required, IsBech32Address()).
+				Else(validation.Empty)),
+	)
+}
+
+// ValidPaymentAmountRule checks that the payment is a positive amount of ncheq
+func ValidPaymentAmountRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*sdk.Coin)
+		if !ok {
+			panic("ValidPaymentAmountRule must be only applied on coin properties")
+		}
+
+		if casted == nil {
+			return errors.New("amount must be set")
+		}
+
+		if err := casted.Validate(); err != nil {
+			return err
+		}
+
+		if casted.Denom != BaseDenom {
+			return fmt.Errorf("amount denom must be %s, got: %s", BaseDenom, casted.Denom)
+		}
+
+		if !casted.IsPositive() {
+			return errors.New("amount must be positive")
+		}
+
+		return nil
+	})
+}
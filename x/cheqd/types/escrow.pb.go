@@ -0,0 +1,660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EscrowStatus is the state of a payment locked in escrow
+type EscrowStatus int32
+
+const (
+	EscrowStatus_ESCROW_STATUS_UNSPECIFIED EscrowStatus = 0
+	// ESCROW_STATUS_LOCKED means that the payment is held by the escrow module account
+	EscrowStatus_ESCROW_STATUS_LOCKED EscrowStatus = 1
+	// ESCROW_STATUS_CLAIMED means that the payment was sent to the issuer
+	EscrowStatus_ESCROW_STATUS_CLAIMED EscrowStatus = 2
+	// ESCROW_STATUS_RECLAIMED means that the payment was returned to the holder after the timeout
+	EscrowStatus_ESCROW_STATUS_RECLAIMED EscrowStatus = 3
+)
+
+var EscrowStatus_name = map[int32]string{
+	0: "ESCROW_STATUS_UNSPECIFIED",
+	1: "ESCROW_STATUS_LOCKED",
+	2: "ESCROW_STATUS_CLAIMED",
+	3: "ESCROW_STATUS_RECLAIMED",
+}
+
+var EscrowStatus_value = map[string]int32{
+	"ESCROW_STATUS_UNSPECIFIED": 0,
+	"ESCROW_STATUS_LOCKED":      1,
+	"ESCROW_STATUS_CLAIMED":     2,
+	"ESCROW_STATUS_RECLAIMED":   3,
+}
+
+func (x EscrowStatus) String() string {
+	return proto.EnumName(EscrowStatus_name, int32(x))
+}
+
+func (EscrowStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db150f183dcae03b, []int{0}
+}
+
+// Escrow is a payment a holder locks for a credential request of ADR-001.
+// The issuer checks that the payment is locked before issuing the credential and then claims it
+// with a signature of an assertion key of its DID. The holder can reclaim the payment once it expires.
+// It's stored under the "escrow:" prefix by issuer, holder and request id, so other accounts can't take a request id.
+// Claimed and reclaimed escrows are kept, so the holder can't lock a payment for the same request twice.
+type Escrow struct {
+	// request_id is the id of the credential request the payment is made for
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// issuer is the DID of the issuer that can claim the payment
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// holder is the address of the account that locked the payment
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the locked payment, only ncheq is accepted
+	Amount *types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// expires_at is the block time after which the holder can reclaim the payment, as a unix timestamp in seconds
+	ExpiresAt int64        `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Status    EscrowStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cheqdid.cheqdnode.cheqd.v1.EscrowStatus" json:"status,omitempty"`
+	// recipient is the address the payment was claimed to. Set by the ledger.
+	Recipient string `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db150f183dcae03b, []int{0}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *Escrow) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Escrow) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Escrow) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Escrow) GetStatus() EscrowStatus {
+	if m != nil {
+		return m.Status
+	}
+	return EscrowStatus_ESCROW_STATUS_UNSPECIFIED
+}
+
+func (m *Escrow) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cheqdid.cheqdnode.cheqd.v1.EscrowStatus", EscrowStatus_name, EscrowStatus_value)
+	proto.RegisterType((*Escrow)(nil), "cheqdid.cheqdnode.cheqd.v1.Escrow")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/escrow.proto", fileDescriptor_db150f183dcae03b) }
+
+var fileDescriptor_db150f183dcae03b = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0xeb, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x4d, 0x2b, 0x8d, 0x22, 0x23, 0x38, 0xcd, 0xa6, 0x2b, 0xc5, 0x53, 0x15, 0x4c,
+	0xe9, 0x7c, 0x03, 0xce, 0xae, 0x42, 0x71, 0x3a, 0x69, 0x37, 0x04, 0x2f, 0xa5, 0x7f, 0x82, 0x0b,
+	0xb8, 0xa6, 0x6b, 0xd2, 0x39, 0x4f, 0x9e, 0xbd, 0xf9, 0xb2, 0x3c, 0xee, 0xe8, 0x51, 0xb6, 0x37,
+	0x22, 0x6d, 0x33, 0xf8, 0xed, 0xf0, 0xbb, 0x24, 0x79, 0x3e, 0xdf, 0xe7, 0x09, 0xdf, 0x2f, 0x09,
+	0x1c, 0x66, 0x1b, 0xba, 0xcb, 0x9d, 0xbd, 0xeb, 0x50, 0x91, 0x55, 0xfc, 0x3b, 0x29, 0x2b, 0x2e,
+	0x39, 0x1a, 0xb7, 0x98, 0xe5, 0xa4, 0xdd, 0x0b, 0x9e, 0xd3, 0xee, 0x44, 0xf6, 0xee, 0xd8, 0xcc,
+	0xb8, 0xd8, 0x72, 0xe1, 0xa4, 0x89, 0xa0, 0xce, 0xde, 0x4d, 0xa9, 0x4c, 0x5c, 0x27, 0xe3, 0xac,
+	0xe8, 0x66, 0x9f, 0xff, 0xea, 0x41, 0xdd, 0x6f, 0x2f, 0x43, 0x13, 0x08, 0x2b, 0xba, 0xab, 0xa9,
+	0x90, 0x31, 0xcb, 0x31, 0xb0, 0x80, 0x6d, 0x84, 0x86, 0x22, 0x41, 0x8e, 0x1e, 0x43, 0x9d, 0x09,
+	0x51, 0xd3, 0x0a, 0xf7, 0x5a, 0x49, 0x55, 0x0d, 0xdf, 0xf0, 0x6f, 0x39, 0xad, 0x70, 0xbf, 0xe3,
+	0x5d, 0x85, 0x5c, 0xa8, 0x27, 0x5b, 0x5e, 0x17, 0x12, 0xdf, 0xb1, 0x80, 0x7d, 0x7f, 0x3a, 0x22,
+	0x9d, 0x15, 0xd2, 0x58, 0x21, 0xca, 0x0a, 0xf1, 0x38, 0x2b, 0x42, 0xd5, 0xd8, 0x38, 0xa0, 0x87,
+	0x92, 0x55, 0x54, 0xc4, 0x89, 0xc4, 0x77, 0x2d, 0x60, 0xf7, 0x43, 0x43, 0x91, 0x99, 0x44, 0x6f,
+	0xa0, 0x2e, 0x64, 0x22, 0x6b, 0x81, 0x75, 0x0b, 0xd8, 0x0f, 0xa7, 0x36, 0xb9, 0x3d, 0x38, 0xe9,
+	0x42, 0x45, 0x6d, 0x7f, 0xa8, 0xe6, 0xd0, 0x33, 0x68, 0x54, 0x34, 0x63, 0x25, 0xa3, 0x85, 0xc4,
+	0xf7, 0x2e, 0x09, 0x15, 0x78, 0xf9, 0x13, 0x3e, 0xb8, 0x39, 0x85, 0x26, 0x70, 0xe4, 0x47, 0x5e,
+	0xb8, 0xfc, 0x1c, 0x47, 0xab, 0xd9, 0x6a, 0x1d, 0xc5, 0xeb, 0x8f, 0xd1, 0x27, 0xdf, 0x0b, 0xde,
+	0x05, 0xfe, 0x7c, 0xa0, 0x21, 0x0c, 0x1f, 0x5d, 0xcb, 0x8b, 0xa5, 0xf7, 0xde, 0x9f, 0x0f, 0x00,
+	0x1a, 0xc1, 0xe1, 0xb5, 0xe2, 0x2d, 0x66, 0xc1, 0x07, 0x7f, 0x3e, 0xe8, 0xa1, 0xa7, 0xf0, 0xc9,
+	0xb5, 0x14, 0xfa, 0x17, 0xb1, 0xff, 0xd6, 0xfb, 0x73, 0x32, 0xc1, 0xf1, 0x64, 0x82, 0x7f, 0x27,
+	0x13, 0xfc, 0x3e, 0x9b, 0xda, 0xf1, 0x6c, 0x6a, 0x7f, 0xcf, 0xa6, 0xf6, 0xe5, 0xc5, 0x57, 0x26,
+	0x37, 0x75, 0x4a, 0x32, 0xbe, 0x75, 0xba, 0x4f, 0xd0, 0xae, 0xaf, 0x9a, 0xcc, 0xce, 0x41, 0x21,
+	0xf9, 0xa3, 0xa4, 0x22, 0xd5, 0xdb, 0x87, 0x7d, 0xfd, 0x7f, 0x00, 0xc4, 0x14, 0x5b, 0x46, 0x2d,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEscrow(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEscrow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEscrow(uint64(m.ExpiresAt))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEscrow(uint64(m.Status))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EscrowStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrowValidation(t *testing.T) {
+	const issuer = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+
+	holder := sdk.AccAddress([]byte("holder______________")).String()
+	amount := sdk.NewInt64Coin(BaseDenom, 1000)
+
+	claimed := NewEscrow("request-1", issuer, holder, amount, 1000)
+	claimed.Status = EscrowStatus_ESCROW_STATUS_CLAIMED
+	claimed.Recipient = holder
+
+	notClaimed := NewEscrow("request-1", issuer, holder, amount, 1000)
+	notClaimed.Recipient = holder
+
+	cases := []struct {
+		name     string
+		escrow   Escrow
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: locked payment",
+			escrow:  NewEscrow("request-1", issuer, holder, amount, 1000),
+			isValid: true,
+		},
+		{
+			name:    "positive: claimed payment",
+			escrow:  claimed,
+			isValid: true,
+		},
+		{
+			name:     "negative: recipient of a payment that isn't claimed",
+			escrow:   notClaimed,
+			isValid:  false,
+			errorMsg: "recipient: must be blank.",
+		},
+		{
+			name:     "negative: payment isn't in ncheq",
+			escrow:   NewEscrow("request-1", issuer, holder, sdk.NewInt64Coin("stake", 1000), 1000),
+			isValid:  false,
+			errorMsg: "amount: amount denom must be ncheq, got: stake.",
+		},
+		{
+			name:     "negative: zero payment",
+			escrow:   NewEscrow("request-1", issuer, holder, sdk.NewInt64Coin(BaseDenom, 0), 1000),
+			isValid:  false,
+			errorMsg: "amount: amount must be positive.",
+		},
+		{
+			name:     "negative: holder isn't an address",
+			escrow:   NewEscrow("request-1", issuer, issuer, amount, 1000),
+			isValid:  false,
+			errorMsg: "holder: decoding bech32 failed: invalid separator index -1.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.escrow.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
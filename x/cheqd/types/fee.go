@@ -0,0 +1,126 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BaseDenom is the denomination identity fees are charged in
+const BaseDenom = "ncheq"
+
+var (
+	DefaultCreateDidFee           = sdk.NewCoin(BaseDenom, sdk.NewInt(50_000_000_000))
+	DefaultUpdateDidFee           = sdk.NewCoin(BaseDenom, sdk.NewInt(25_000_000_000))
+	DefaultDeactivateDidFee       = sdk.NewCoin(BaseDenom, sdk.NewInt(10_000_000_000))
+	DefaultFeeBurnFactor          = "0.5"
+	DefaultFeeCommunityPoolFactor = "0.0"
+)
+
+func NewFeeParams(createDid, updateDid, deactivateDid sdk.Coin, burnFactor, communityPoolFactor string) FeeParams {
+	return FeeParams{
+		CreateDid:           &createDid,
+		UpdateDid:           &updateDid,
+		DeactivateDid:       &deactivateDid,
+		BurnFactor:          burnFactor,
+		CommunityPoolFactor: communityPoolFactor,
+	}
+}
+
+// DefaultFeeParams returns default identity fees
+func DefaultFeeParams() FeeParams {
+	return NewFeeParams(DefaultCreateDidFee, DefaultUpdateDidFee, DefaultDeactivateDidFee, DefaultFeeBurnFactor, DefaultFeeCommunityPoolFactor)
+}
+
+// SplitFee divides a fee into the parts that are burnt, sent to the community pool and sent to the fee collector.
+// Rounding leftovers go to the fee collector.
+func (p FeeParams) SplitFee(fee sdk.Coins) (burn sdk.Coins, communityPool sdk.Coins, feeCollector sdk.Coins, err error) {
+	burnFactor, err := sdk.NewDecFromStr(p.BurnFactor)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	communityPoolFactor, err := sdk.NewDecFromStr(p.CommunityPoolFactor)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	burn, _ = sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(burnFactor).TruncateDecimal()
+	communityPool, _ = sdk.NewDecCoinsFromCoins(fee...).MulDecTruncate(communityPoolFactor).TruncateDecimal()
+	feeCollector = fee.Sub(burn).Sub(communityPool)
+
+	return burn, communityPool, feeCollector, nil
+}
+
+// Validation
+
+func (p FeeParams) Validate() error {
+	for _, fee := range []*sdk.Coin{p.CreateDid, p.UpdateDid, p.DeactivateDid} {
+		if err := validateFee(fee); err != nil {
+			return err
+		}
+	}
+
+	burnFactor, err := validateFactor(p.BurnFactor, "burn factor")
+	if err != nil {
+		return err
+	}
+
+	communityPoolFactor, err := validateFactor(p.CommunityPoolFactor, "community pool factor")
+	if err != nil {
+		return err
+	}
+
+	if burnFactor.Add(communityPoolFactor).GT(sdk.OneDec()) {
+		return errors.New("sum of burn and community pool factors must not exceed 1")
+	}
+
+	return nil
+}
+
+func validateFee(fee *sdk.Coin) error {
+	if fee == nil {
+		return errors.New("fee must be set")
+	}
+
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	if fee.Denom != BaseDenom {
+		return fmt.Errorf("fee denom must be %s, got: %s", BaseDenom, fee.Denom)
+	}
+
+	return nil
+}
+
+func validateFactor(factor string, name string) (sdk.Dec, error) {
+	res, err := sdk.NewDecFromStr(factor)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("invalid %s: %s", name, err.Error())
+	}
+
+	if res.IsNegative() || res.GT(sdk.OneDec()) {
+		return sdk.Dec{}, fmt.Errorf("%s must be between 0 and 1, got: %s", name, factor)
+	}
+
+	return res, nil
+}
+
+// validateFeeParams accepts both FeeParams and *FeeParams,
+// as the params subspace passes the value by pointer on SetParamSet and by value on governance updates.
+func validateFeeParams(i interface{}) error {
+	switch v := i.(type) {
+	case FeeParams:
+		return v.Validate()
+	case *FeeParams:
+		if v == nil {
+			return errors.New("fee params must be set")
+		}
+
+		return v.Validate()
+	default:
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitFee(t *testing.T) {
+	cases := []struct {
+		name                string
+		burnFactor          string
+		communityPoolFactor string
+		fee                 int64
+		burn                int64
+		communityPool       int64
+		feeCollector        int64
+	}{
+		{
+			name:                "everything goes to the fee collector",
+			burnFactor:          "0",
+			communityPoolFactor: "0",
+			fee:                 100,
+			feeCollector:        100,
+		},
+		{
+			name:                "three way split",
+			burnFactor:          "0.5",
+			communityPoolFactor: "0.2",
+			fee:                 100,
+			burn:                50,
+			communityPool:       20,
+			feeCollector:        30,
+		},
+		{
+			name:                "rounding leftovers go to the fee collector",
+			burnFactor:          "0.5",
+			communityPoolFactor: "0.5",
+			fee:                 3,
+			burn:                1,
+			communityPool:       1,
+			feeCollector:        1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultFeeParams()
+			params.BurnFactor = tc.burnFactor
+			params.CommunityPoolFactor = tc.communityPoolFactor
+
+			burn, communityPool, feeCollector, err := params.SplitFee(sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, tc.fee)))
+			require.NoError(t, err)
+			require.Equal(t, tc.burn, burn.AmountOf(BaseDenom).Int64())
+			require.Equal(t, tc.communityPool, communityPool.AmountOf(BaseDenom).Int64())
+			require.Equal(t, tc.feeCollector, feeCollector.AmountOf(BaseDenom).Int64())
+		})
+	}
+}
+
+func TestFeeParamsValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		update   func(params *FeeParams)
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: default fee params",
+			update:  func(params *FeeParams) {},
+			isValid: true,
+		},
+		{
+			name: "positive: zero fee",
+			update: func(params *FeeParams) {
+				fee := sdk.NewInt64Coin(BaseDenom, 0)
+				params.CreateDid = &fee
+			},
+			isValid: true,
+		},
+		{
+			name:     "negative: missing fee",
+			update:   func(params *FeeParams) { params.UpdateDid = nil },
+			isValid:  false,
+			errorMsg: "fee must be set",
+		},
+		{
+			name: "negative: wrong denom",
+			update: func(params *FeeParams) {
+				fee := sdk.NewInt64Coin("stake", 1)
+				params.DeactivateDid = &fee
+			},
+			isValid:  false,
+			errorMsg: "fee denom must be ncheq, got: stake",
+		},
+		{
+			name:     "negative: factor is out of range",
+			update:   func(params *FeeParams) { params.BurnFactor = "1.5" },
+			isValid:  false,
+			errorMsg: "burn factor must be between 0 and 1, got: 1.5",
+		},
+		{
+			name: "negative: factors sum exceeds 1",
+			update: func(params *FeeParams) {
+				params.BurnFactor = "0.6"
+				params.CommunityPoolFactor = "0.6"
+			},
+			isValid:  false,
+			errorMsg: "sum of burn and community pool factors must not exceed 1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultFeeParams()
+			tc.update(&params)
+			err := params.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	DefaultSigVerifyCostEd25519     uint64 = 590
+	DefaultSigVerifyCostSecp256k1   uint64 = 1000
+	DefaultSigVerifyCostSecp256r1   uint64 = 1000
+	DefaultSigVerifyCostRSA         uint64 = 2000
+	DefaultControllerResolutionCost uint64 = 1000
+	DefaultDocumentByteCost         uint64 = 10
+)
+
+func NewGasParams(sigVerifyCostEd25519, sigVerifyCostSecp256k1, sigVerifyCostSecp256r1, sigVerifyCostRSA, controllerResolutionCost, documentByteCost uint64) GasParams {
+	return GasParams{
+		SigVerifyCostEd25519:     sigVerifyCostEd25519,
+		SigVerifyCostSecp256K1:   sigVerifyCostSecp256k1,
+		SigVerifyCostSecp256R1:   sigVerifyCostSecp256r1,
+		SigVerifyCostRsa:         sigVerifyCostRSA,
+		ControllerResolutionCost: controllerResolutionCost,
+		DocumentByteCost:         documentByteCost,
+	}
+}
+
+// DefaultGasParams returns default gas costs of identity operations
+func DefaultGasParams() GasParams {
+	return NewGasParams(
+		DefaultSigVerifyCostEd25519,
+		DefaultSigVerifyCostSecp256k1,
+		DefaultSigVerifyCostSecp256r1,
+		DefaultSigVerifyCostRSA,
+		DefaultControllerResolutionCost,
+		DefaultDocumentByteCost,
+	)
+}
+
+// DefaultIdentitySigVerificationGasConsumer consumes gas for an identity signature verification
+// based upon the public key type of the verification method. Modelled on ante.DefaultSigVerificationGasConsumer.
+func DefaultIdentitySigVerificationGasConsumer(meter sdk.GasMeter, vm VerificationMethod, params GasParams) error {
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return ErrInvalidPublicKey.Wrapf("verification method: %s, err: %s", vm.Id, err.Error())
+	}
+
+	switch pubKey := pubKey.(type) {
+	case ed25519.PublicKey:
+		meter.ConsumeGas(params.SigVerifyCostEd25519, "identity signature verification: ed25519")
+		return nil
+
+	case *ecdsa.PublicKey:
+		if utils.IsSecp256k1PubKey(*pubKey) {
+			meter.ConsumeGas(params.SigVerifyCostSecp256K1, "identity signature verification: secp256k1")
+			return nil
+		}
+
+		meter.ConsumeGas(params.SigVerifyCostSecp256R1, "identity signature verification: secp256r1")
+		return nil
+
+	case *rsa.PublicKey:
+		meter.ConsumeGas(params.SigVerifyCostRsa, "identity signature verification: rsa")
+		return nil
+
+	default:
+		return ErrInvalidPublicKey.Wrapf("verification method: %s, unrecognized public key type: %T", vm.Id, pubKey)
+	}
+}
+
+// ConsumeDocumentGas consumes gas proportional to the serialised size of the DID Doc
+func (p GasParams) ConsumeDocumentGas(meter sdk.GasMeter, did Did) {
+	p.consumeBytesGas(meter, did.Size(), "DID Doc size")
+}
+
+// ConsumeResourceGas consumes gas proportional to the serialised size of a ledger resource, such as a schema
+func (p GasParams) ConsumeResourceGas(meter sdk.GasMeter, data StateValueData, descriptor string) {
+	p.consumeBytesGas(meter, proto.Size(data), descriptor)
+}
+
+// ConsumePaymentReceiptGas consumes gas proportional to the serialised size of a payment receipt
+func (p GasParams) ConsumePaymentReceiptGas(meter sdk.GasMeter, receipt PaymentReceipt) {
+	p.consumeBytesGas(meter, receipt.Size(), "payment receipt size")
+}
+
+// consumeBytesGas panics with ErrorGasOverflow if the cost doesn't fit in uint64, like the sdk gas meter does
+func (p GasParams) consumeBytesGas(meter sdk.GasMeter, size int, descriptor string) {
+	hi, cost := bits.Mul64(p.DocumentByteCost, uint64(size))
+	if hi != 0 {
+		panic(sdk.ErrorGasOverflow{Descriptor: descriptor})
+	}
+
+	meter.ConsumeGas(cost, descriptor)
+}
+
+// ConsumeResolutionGas consumes gas for a DID resolution
+func (p GasParams) ConsumeResolutionGas(meter sdk.GasMeter) {
+	meter.ConsumeGas(p.ControllerResolutionCost, "DID resolution")
+}
+
+// Validation
+
+// validateGasParams accepts both GasParams and *GasParams, see validateFeeParams.
+// Costs are unsigned, so any value is valid. Zero disables metering of the operation.
+func validateGasParams(i interface{}) error {
+	switch v := i.(type) {
+	case GasParams:
+		return nil
+	case *GasParams:
+		if v == nil {
+			return errors.New("gas params must be set")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdentitySigVerificationGasConsumer(t *testing.T) {
+	params := NewGasParams(1, 2, 3, 4, 0, 0)
+
+	edPubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p256PrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	secpPrivKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	secpPrivKey.PublicKey.X.FillBytes(x)
+	secpPrivKey.PublicKey.Y.FillBytes(y)
+	secpVM := VerificationMethod{
+		Id:   jwsTestKid,
+		Type: JsonWebKey2020,
+		PublicKeyJwk: JSONToPubKeyJWK(fmt.Sprintf(`{"kty":"EC","crv":"secp256k1","x":"%s","y":"%s"}`,
+			base64.RawURLEncoding.EncodeToString(x), base64.RawURLEncoding.EncodeToString(y))),
+	}
+
+	cases := []struct {
+		name     string
+		vm       VerificationMethod
+		expected uint64
+	}{
+		{
+			name:     "ed25519",
+			vm:       jwkVerificationMethod(t, edPubKey),
+			expected: 1,
+		},
+		{
+			name:     "secp256k1",
+			vm:       secpVM,
+			expected: 2,
+		},
+		{
+			name:     "secp256r1",
+			vm:       jwkVerificationMethod(t, p256PrivKey.PublicKey),
+			expected: 3,
+		},
+		{
+			name:     "rsa",
+			vm:       jwkVerificationMethod(t, rsaPrivKey.PublicKey),
+			expected: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			meter := sdk.NewInfiniteGasMeter()
+
+			err := DefaultIdentitySigVerificationGasConsumer(meter, tc.vm, params)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, meter.GasConsumed())
+		})
+	}
+}
+
+func TestConsumeDocumentGas(t *testing.T) {
+	did := Did{Id: "did:cheqd:test:1234567890abcdef"}
+
+	meter := sdk.NewInfiniteGasMeter()
+	NewGasParams(0, 0, 0, 0, 0, 10).ConsumeDocumentGas(meter, did)
+	require.Equal(t, 10*uint64(did.Size()), meter.GasConsumed())
+
+	params := NewGasParams(0, 0, 0, 0, 0, math.MaxUint64/2)
+	require.PanicsWithValue(t, sdk.ErrorGasOverflow{Descriptor: "DID Doc size"}, func() {
+		params.ConsumeDocumentGas(sdk.NewInfiniteGasMeter(), did)
+	})
+	require.PanicsWithValue(t, sdk.ErrorGasOverflow{Descriptor: "schema size"}, func() {
+		params.ConsumeResourceGas(sdk.NewInfiniteGasMeter(), &did, "schema size")
+	})
+}
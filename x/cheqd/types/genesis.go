@@ -2,15 +2,22 @@ package types
 
 import (
 	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
 const DefaultDidNamespace = "testnet"
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
+
+	namespace := NewNamespace(DefaultDidNamespace, NamespaceStatus_NAMESPACE_STATUS_ACTIVE)
+
 	return &GenesisState{
-		DidList:      []*StateValue{},
-		DidNamespace: DefaultDidNamespace,
+		DidList:    []*StateValue{},
+		Params:     &params,
+		Namespaces: []*Namespace{&namespace},
 	}
 }
 
@@ -32,5 +39,192 @@ func (gs GenesisState) Validate() error {
 		didIdMap[did.Id] = true
 	}
 
+	for _, elem := range gs.DidVersions {
+		did, err := elem.UnpackDataAsDid()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := didIdMap[did.Id]; !ok {
+			return fmt.Errorf("did version has no latest did: %s", did.Id)
+		}
+	}
+
+	err := validation.Validate(gs.Namespaces, IsUniqueNamespaceListRule(), validation.Each(ValidNamespaceRule()))
+	if err != nil {
+		return fmt.Errorf("namespaces: %s", err.Error())
+	}
+
+	err = validation.Validate(gs.TrustedIssuers, IsUniqueTrustedIssuerListRule(), validation.Each(ValidTrustedIssuerRule()))
+	if err != nil {
+		return fmt.Errorf("trusted issuers: %s", err.Error())
+	}
+
+	for _, trustedIssuer := range gs.TrustedIssuers {
+		if _, ok := didIdMap[trustedIssuer.Issuer]; !ok {
+			return fmt.Errorf("trusted issuer DID not found: %s", trustedIssuer.Issuer)
+		}
+	}
+
+	err = validation.Validate(gs.ReservedIds, IsUniqueReservedIdListRule(), validation.Each(ValidReservedIdRule()))
+	if err != nil {
+		return fmt.Errorf("reserved ids: %s", err.Error())
+	}
+
+	for _, reservedId := range gs.ReservedIds {
+		if _, ok := didIdMap[reservedId.Did]; ok {
+			return fmt.Errorf("reserved DID exists: %s", reservedId.Did)
+		}
+	}
+
+	schemaIdMap := make(map[string]bool)
+
+	for _, elem := range gs.Schemas {
+		schema, err := elem.UnpackDataAsSchema()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := schemaIdMap[schema.Id]; ok {
+			return fmt.Errorf("duplicated id for schema")
+		}
+
+		schemaIdMap[schema.Id] = true
+	}
+
+	credDefIdMap := make(map[string]bool)
+
+	for _, elem := range gs.CredDefs {
+		credDef, err := elem.UnpackDataAsCredDef()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := credDefIdMap[credDef.Id]; ok {
+			return fmt.Errorf("duplicated id for cred def")
+		}
+
+		if _, ok := schemaIdMap[credDef.SchemaId]; !ok {
+			return fmt.Errorf("cred def schema not found: %s", credDef.SchemaId)
+		}
+
+		credDefIdMap[credDef.Id] = true
+	}
+
+	revocRegDefIdMap := make(map[string]bool)
+
+	for _, elem := range gs.RevocRegDefs {
+		revocRegDef, err := elem.UnpackDataAsRevocRegDef()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := revocRegDefIdMap[revocRegDef.Id]; ok {
+			return fmt.Errorf("duplicated id for revoc reg def")
+		}
+
+		if _, ok := credDefIdMap[revocRegDef.CredDefId]; !ok {
+			return fmt.Errorf("revoc reg def cred def not found: %s", revocRegDef.CredDefId)
+		}
+
+		revocRegDefIdMap[revocRegDef.Id] = true
+	}
+
+	revocRegEntryIdMap := make(map[string]bool)
+
+	for _, elem := range gs.RevocRegEntries {
+		entry, err := elem.UnpackDataAsRevocRegEntry()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := revocRegEntryIdMap[entry.RevocRegDefId]; ok {
+			return fmt.Errorf("duplicated latest entry for revoc reg: %s", entry.RevocRegDefId)
+		}
+
+		if _, ok := revocRegDefIdMap[entry.RevocRegDefId]; !ok {
+			return fmt.Errorf("revoc reg entry def not found: %s", entry.RevocRegDefId)
+		}
+
+		revocRegEntryIdMap[entry.RevocRegDefId] = true
+	}
+
+	for _, entry := range gs.RevocRegEntryHistory {
+		if _, ok := revocRegEntryIdMap[entry.RevocRegDefId]; !ok {
+			return fmt.Errorf("revoc reg entry history has no latest entry: %s", entry.RevocRegDefId)
+		}
+	}
+
+	statusListMap := make(map[string]*StatusList)
+
+	for _, elem := range gs.StatusLists {
+		statusList, err := elem.UnpackDataAsStatusList()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := statusListMap[statusList.Id]; ok {
+			return fmt.Errorf("duplicated id for status list")
+		}
+
+		statusListMap[statusList.Id] = statusList
+	}
+
+	for _, chunk := range gs.StatusListChunks {
+		statusList, ok := statusListMap[chunk.StatusListId]
+		if !ok {
+			return fmt.Errorf("status list chunk list not found: %s", chunk.StatusListId)
+		}
+
+		if chunk.Index >= statusList.ChunkCount() || len(chunk.Bits) != StatusListChunkSize {
+			return fmt.Errorf("invalid status list chunk: %s, %d", chunk.StatusListId, chunk.Index)
+		}
+	}
+
+	resourceIdMap := make(map[string]bool)
+
+	for _, elem := range gs.Resources {
+		resource, err := elem.UnpackDataAsResource()
+		if err != nil {
+			return err
+		}
+
+		key := resource.Header.CollectionId + "/" + resource.Header.Id
+		if _, ok := resourceIdMap[key]; ok {
+			return fmt.Errorf("duplicated id for resource: %s", key)
+		}
+
+		if _, ok := didIdMap[resource.Header.CollectionId]; !ok {
+			return fmt.Errorf("resource collection DID not found: %s", resource.Header.CollectionId)
+		}
+
+		resourceIdMap[key] = true
+	}
+
+	escrowMap := make(map[string]bool)
+
+	for _, escrow := range gs.Escrows {
+		key := escrow.Issuer + "/" + escrow.Holder + "/" + escrow.RequestId
+		if _, ok := escrowMap[key]; ok {
+			return fmt.Errorf("duplicated escrow: %s", key)
+		}
+
+		if err := escrow.Validate(nil); err != nil {
+			return fmt.Errorf("invalid escrow %s: %s", escrow.RequestId, err.Error())
+		}
+
+		escrowMap[key] = true
+	}
+
+	for _, receipt := range gs.PaymentReceipts {
+		if err := receipt.Validate(); err != nil {
+			return fmt.Errorf("invalid payment receipt %s: %s", receipt.RequestId, err.Error())
+		}
+	}
+
+	if gs.Params != nil {
+		return gs.Params.Validate()
+	}
+
 	return nil
 }
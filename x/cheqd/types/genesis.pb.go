@@ -24,8 +24,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the cheqd module's genesis state.
 type GenesisState struct {
-	DidNamespace string        `protobuf:"bytes,1,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
-	DidList      []*StateValue `protobuf:"bytes,2,rep,name=didList,proto3" json:"didList,omitempty"`
+	// did_namespace is deprecated, use namespaces. If set, it's registered as an active namespace.
+	DidNamespace   string           `protobuf:"bytes,1,opt,name=did_namespace,json=didNamespace,proto3" json:"did_namespace,omitempty"`
+	DidList        []*StateValue    `protobuf:"bytes,2,rep,name=didList,proto3" json:"didList,omitempty"`
+	Params         *Params          `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	Namespaces     []*Namespace     `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	TrustedIssuers []*TrustedIssuer `protobuf:"bytes,5,rep,name=trusted_issuers,json=trustedIssuers,proto3" json:"trusted_issuers,omitempty"`
+	ReservedIds    []*ReservedId    `protobuf:"bytes,6,rep,name=reserved_ids,json=reservedIds,proto3" json:"reserved_ids,omitempty"`
+	Schemas        []*StateValue    `protobuf:"bytes,7,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	CredDefs       []*StateValue    `protobuf:"bytes,8,rep,name=cred_defs,json=credDefs,proto3" json:"cred_defs,omitempty"`
+	RevocRegDefs   []*StateValue    `protobuf:"bytes,9,rep,name=revoc_reg_defs,json=revocRegDefs,proto3" json:"revoc_reg_defs,omitempty"`
+	// revoc_reg_entries holds the latest entry of each revocation registry
+	RevocRegEntries      []*StateValue      `protobuf:"bytes,10,rep,name=revoc_reg_entries,json=revocRegEntries,proto3" json:"revoc_reg_entries,omitempty"`
+	RevocRegEntryHistory []*RevocRegEntry   `protobuf:"bytes,11,rep,name=revoc_reg_entry_history,json=revocRegEntryHistory,proto3" json:"revoc_reg_entry_history,omitempty"`
+	StatusLists          []*StateValue      `protobuf:"bytes,12,rep,name=status_lists,json=statusLists,proto3" json:"status_lists,omitempty"`
+	StatusListChunks     []*StatusListChunk `protobuf:"bytes,13,rep,name=status_list_chunks,json=statusListChunks,proto3" json:"status_list_chunks,omitempty"`
+	Resources            []*StateValue      `protobuf:"bytes,14,rep,name=resources,proto3" json:"resources,omitempty"`
+	Escrows              []*Escrow          `protobuf:"bytes,15,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	PaymentReceipts      []*PaymentReceipt  `protobuf:"bytes,16,rep,name=payment_receipts,json=paymentReceipts,proto3" json:"payment_receipts,omitempty"`
+	// did_versions holds every version of the DID Docs, including the latest ones
+	DidVersions []*StateValue `protobuf:"bytes,17,rep,name=did_versions,json=didVersions,proto3" json:"did_versions,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -75,6 +93,111 @@ func (m *GenesisState) GetDidList() []*StateValue {
 	return nil
 }
 
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNamespaces() []*Namespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrustedIssuers() []*TrustedIssuer {
+	if m != nil {
+		return m.TrustedIssuers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReservedIds() []*ReservedId {
+	if m != nil {
+		return m.ReservedIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSchemas() []*StateValue {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredDefs() []*StateValue {
+	if m != nil {
+		return m.CredDefs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocRegDefs() []*StateValue {
+	if m != nil {
+		return m.RevocRegDefs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocRegEntries() []*StateValue {
+	if m != nil {
+		return m.RevocRegEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevocRegEntryHistory() []*RevocRegEntry {
+	if m != nil {
+		return m.RevocRegEntryHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatusLists() []*StateValue {
+	if m != nil {
+		return m.StatusLists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStatusListChunks() []*StatusListChunk {
+	if m != nil {
+		return m.StatusListChunks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetResources() []*StateValue {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []*Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymentReceipts() []*PaymentReceipt {
+	if m != nil {
+		return m.PaymentReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDidVersions() []*StateValue {
+	if m != nil {
+		return m.DidVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cheqdid.cheqdnode.cheqd.v1.GenesisState")
 }
@@ -82,21 +205,46 @@ func init() {
 func init() { proto.RegisterFile("cheqd/v1/genesis.proto", fileDescriptor_85a78c6000d41e7d) }
 
 var fileDescriptor_85a78c6000d41e7d = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x2d,
-	0x4c, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x02, 0x8b, 0x67, 0xa6, 0xe8, 0x81, 0xe9, 0xbc, 0xfc, 0x94, 0x54, 0x08,
-	0x4b, 0xaf, 0xcc, 0x50, 0x4a, 0x12, 0xae, 0xa7, 0xb8, 0x24, 0xb1, 0x24, 0x35, 0x2c, 0x31, 0xa7,
-	0x34, 0x15, 0xa2, 0x4d, 0xa9, 0x94, 0x8b, 0xc7, 0x1d, 0x62, 0x4e, 0x30, 0x48, 0x4a, 0x48, 0x99,
-	0x8b, 0x37, 0x25, 0x33, 0x25, 0x3e, 0x2f, 0x31, 0x37, 0xb5, 0xb8, 0x20, 0x31, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x27, 0x25, 0x33, 0xc5, 0x0f, 0x26, 0x26, 0xe4, 0xc0, 0xc5,
-	0x9e, 0x92, 0x99, 0xe2, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa6,
-	0x87, 0xdb, 0x76, 0xbd, 0x60, 0xb8, 0x9d, 0x41, 0x30, 0x6d, 0x4e, 0xce, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x99, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x0f, 0x71, 0x36, 0x98, 0xd4, 0x05, 0x99, 0xa9, 0x5f, 0x01, 0x15, 0x2a, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x99, 0xa3, 0x00,
-	0xd6, 0x13, 0x01, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0x57, 0x06, 0xdb, 0xea, 0x76, 0xeb, 0x66, 0x01, 0x33, 0x95, 0x88, 0xaa, 0x21, 0xd0,
+	0x06, 0x22, 0xd5, 0xc6, 0x0d, 0x71, 0x40, 0x6c, 0x13, 0x4c, 0x9a, 0x10, 0xf2, 0x60, 0x12, 0x5c,
+	0x42, 0x16, 0xbf, 0xb5, 0x16, 0x6b, 0x12, 0xfc, 0x9c, 0x42, 0xfe, 0x05, 0x3f, 0x8b, 0xe3, 0x8e,
+	0x1c, 0xd1, 0x76, 0xe7, 0x37, 0xa0, 0xd8, 0x49, 0x96, 0x32, 0xd1, 0x2a, 0x97, 0x36, 0x79, 0xcf,
+	0xdf, 0x97, 0xa7, 0x67, 0xfb, 0x91, 0xbb, 0xc1, 0x10, 0xbe, 0x8a, 0xfe, 0x78, 0xbb, 0x3f, 0x80,
+	0x10, 0x50, 0xa2, 0x1b, 0xab, 0x48, 0x47, 0xb4, 0x6b, 0xe2, 0x52, 0xb8, 0xe6, 0x3f, 0x8c, 0x04,
+	0xd8, 0x27, 0x77, 0xbc, 0xdd, 0xbd, 0x53, 0x32, 0x80, 0x81, 0x8a, 0xbe, 0x59, 0xa4, 0xcb, 0xca,
+	0x70, 0xe8, 0x8f, 0x00, 0x63, 0x3f, 0x80, 0x3c, 0x73, 0x05, 0xc4, 0xbe, 0xf2, 0x47, 0xf9, 0x37,
+	0xba, 0x4e, 0x25, 0x9c, 0x8e, 0x20, 0xd4, 0x9e, 0x82, 0x00, 0x64, 0xac, 0xf3, 0x7c, 0xb7, 0xcc,
+	0x2b, 0x40, 0x50, 0x63, 0x10, 0x9e, 0x14, 0x79, 0x6e, 0xbd, 0x9a, 0x8b, 0x12, 0x15, 0xc0, 0xb5,
+	0x2a, 0x14, 0x8c, 0xa3, 0xc0, 0x53, 0x30, 0xc8, 0x33, 0xf7, 0xca, 0x0c, 0x6a, 0x5f, 0xc3, 0xb1,
+	0x7f, 0x96, 0xc0, 0xb5, 0x2f, 0x65, 0xa9, 0x04, 0xbd, 0x33, 0x89, 0x45, 0x15, 0xf7, 0xcb, 0x9c,
+	0x56, 0x09, 0xea, 0xac, 0x08, 0xc4, 0x04, 0x94, 0x4d, 0x6f, 0xfc, 0x69, 0x92, 0xf6, 0x6b, 0xdb,
+	0xba, 0xa3, 0x4c, 0x4b, 0x1f, 0x90, 0x65, 0x21, 0x85, 0x57, 0xf6, 0x80, 0x35, 0x7a, 0x8d, 0xcd,
+	0x26, 0x6f, 0x0b, 0x29, 0xde, 0x16, 0x31, 0xfa, 0x92, 0x2c, 0x0a, 0x29, 0x0e, 0x25, 0x6a, 0x76,
+	0xa3, 0x37, 0xbf, 0xd9, 0xda, 0x79, 0xe4, 0xfe, 0xbf, 0xe1, 0xee, 0x51, 0x59, 0x2f, 0x2f, 0x30,
+	0xfa, 0x9c, 0x2c, 0xd8, 0x66, 0xb2, 0xf9, 0x5e, 0x63, 0xb3, 0xb5, 0xb3, 0x31, 0x4d, 0xf0, 0xce,
+	0xac, 0xe4, 0x39, 0x41, 0xf7, 0x09, 0x29, 0xcb, 0x43, 0x76, 0xd3, 0x14, 0xf0, 0x70, 0x1a, 0x5f,
+	0x16, 0xce, 0x2b, 0x20, 0xe5, 0xa4, 0x33, 0xd9, 0x12, 0x64, 0xb7, 0x8c, 0x6b, 0x6b, 0x9a, 0xeb,
+	0xbd, 0x45, 0x0e, 0x0c, 0xc1, 0x57, 0x74, 0xf5, 0x15, 0xe9, 0x01, 0x69, 0x57, 0x36, 0x1b, 0xd9,
+	0xc2, 0xec, 0xee, 0xf0, 0x7c, 0xfd, 0x81, 0xe0, 0x2d, 0x55, 0x3e, 0x63, 0xd6, 0x63, 0x0c, 0x86,
+	0x30, 0xf2, 0x91, 0x2d, 0xd6, 0xeb, 0x71, 0x8e, 0xd1, 0x5d, 0xd2, 0x0c, 0x14, 0x08, 0x4f, 0xc0,
+	0x29, 0xb2, 0xa5, 0x5a, 0x8e, 0xa5, 0x0c, 0xdc, 0x83, 0x53, 0xa4, 0x87, 0x64, 0xa5, 0x3c, 0x89,
+	0xd6, 0xd4, 0xac, 0x65, 0x6a, 0x1b, 0x9a, 0xc3, 0xc0, 0xd8, 0x38, 0x59, 0xbb, 0xb2, 0x41, 0xa8,
+	0x95, 0x04, 0x64, 0xa4, 0x96, 0xb0, 0x53, 0x08, 0xf7, 0x2d, 0x4e, 0x3f, 0x93, 0xf5, 0x49, 0x67,
+	0xea, 0x0d, 0x25, 0xea, 0x48, 0xa5, 0xac, 0x35, 0x7b, 0x3f, 0x79, 0xc5, 0x96, 0xf2, 0xdb, 0x55,
+	0x79, 0xfa, 0xc6, 0x6a, 0xb2, 0x5d, 0xad, 0x5c, 0x2c, 0x64, 0xed, 0x5a, 0x05, 0xb7, 0x2c, 0x9b,
+	0x1d, 0x7b, 0xa4, 0x1f, 0x09, 0xad, 0xa8, 0xbc, 0x60, 0x98, 0x84, 0x5f, 0x90, 0x2d, 0x1b, 0xe1,
+	0x93, 0x59, 0x42, 0x2b, 0xd9, 0xcd, 0x18, 0xbe, 0x8a, 0x93, 0x01, 0xa4, 0x7b, 0xa4, 0x59, 0x0c,
+	0x13, 0x64, 0x2b, 0xb5, 0x4a, 0xbc, 0x02, 0xe9, 0x0b, 0xb2, 0x68, 0xc7, 0x22, 0xb2, 0x4e, 0x6f,
+	0x7e, 0xd6, 0xcd, 0xdc, 0x37, 0x4b, 0x79, 0x81, 0xd0, 0x0f, 0x64, 0xf5, 0x9f, 0x61, 0x88, 0x6c,
+	0xd5, 0x68, 0x1e, 0x4f, 0xbf, 0xe0, 0x86, 0xe1, 0x16, 0xe1, 0x9d, 0x78, 0xe2, 0xdd, 0x5c, 0xab,
+	0x6c, 0x28, 0x8d, 0x41, 0xa1, 0x8c, 0x42, 0x64, 0x6b, 0xf5, 0x36, 0x40, 0x48, 0x71, 0x9c, 0xa3,
+	0xaf, 0x76, 0x7f, 0x5e, 0x38, 0x8d, 0xf3, 0x0b, 0xa7, 0xf1, 0xfb, 0xc2, 0x69, 0xfc, 0xb8, 0x74,
+	0xe6, 0xce, 0x2f, 0x9d, 0xb9, 0x5f, 0x97, 0xce, 0xdc, 0xa7, 0xad, 0x81, 0xd4, 0xc3, 0xe4, 0xc4,
+	0x0d, 0xa2, 0x51, 0xdf, 0x0e, 0x4d, 0xf3, 0xfb, 0x34, 0xf3, 0xf6, 0xbf, 0xe7, 0x21, 0x9d, 0xc6,
+	0x80, 0x27, 0x0b, 0x66, 0x78, 0x3e, 0xfb, 0x3b, 0x00, 0x8a, 0x64, 0x4d, 0xa9, 0x7f, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +267,218 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DidVersions) > 0 {
+		for iNdEx := len(m.DidVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PaymentReceipts) > 0 {
+		for iNdEx := len(m.PaymentReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.StatusListChunks) > 0 {
+		for iNdEx := len(m.StatusListChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatusListChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.StatusLists) > 0 {
+		for iNdEx := len(m.StatusLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StatusLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RevocRegEntryHistory) > 0 {
+		for iNdEx := len(m.RevocRegEntryHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocRegEntryHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RevocRegEntries) > 0 {
+		for iNdEx := len(m.RevocRegEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocRegEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RevocRegDefs) > 0 {
+		for iNdEx := len(m.RevocRegDefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevocRegDefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CredDefs) > 0 {
+		for iNdEx := len(m.CredDefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredDefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReservedIds) > 0 {
+		for iNdEx := len(m.ReservedIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReservedIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TrustedIssuers) > 0 {
+		for iNdEx := len(m.TrustedIssuers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedIssuers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DidList) > 0 {
 		for iNdEx := len(m.DidList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -170,37 +530,125 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+	}
+	if len(m.TrustedIssuers) > 0 {
+		for _, e := range m.TrustedIssuers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReservedIds) > 0 {
+		for _, e := range m.ReservedIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredDefs) > 0 {
+		for _, e := range m.CredDefs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocRegDefs) > 0 {
+		for _, e := range m.RevocRegDefs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocRegEntries) > 0 {
+		for _, e := range m.RevocRegEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevocRegEntryHistory) > 0 {
+		for _, e := range m.RevocRegEntryHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StatusLists) > 0 {
+		for _, e := range m.StatusLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StatusListChunks) > 0 {
+		for _, e := range m.StatusListChunks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PaymentReceipts) > 0 {
+		for _, e := range m.PaymentReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DidVersions) > 0 {
+		for _, e := range m.DidVersions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
@@ -274,6 +722,518 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, &Namespace{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedIssuers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedIssuers = append(m.TrustedIssuers, &TrustedIssuer{})
+			if err := m.TrustedIssuers[len(m.TrustedIssuers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedIds = append(m.ReservedIds, &ReservedId{})
+			if err := m.ReservedIds[len(m.ReservedIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, &StateValue{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredDefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredDefs = append(m.CredDefs, &StateValue{})
+			if err := m.CredDefs[len(m.CredDefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegDefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegDefs = append(m.RevocRegDefs, &StateValue{})
+			if err := m.RevocRegDefs[len(m.RevocRegDefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegEntries = append(m.RevocRegEntries, &StateValue{})
+			if err := m.RevocRegEntries[len(m.RevocRegEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegEntryHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegEntryHistory = append(m.RevocRegEntryHistory, &RevocRegEntry{})
+			if err := m.RevocRegEntryHistory[len(m.RevocRegEntryHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusLists = append(m.StatusLists, &StateValue{})
+			if err := m.StatusLists[len(m.StatusLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusListChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusListChunks = append(m.StatusListChunks, &StatusListChunk{})
+			if err := m.StatusListChunks[len(m.StatusListChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &StateValue{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, &Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentReceipts = append(m.PaymentReceipts, &PaymentReceipt{})
+			if err := m.PaymentReceipts[len(m.PaymentReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidVersions = append(m.DidVersions, &StateValue{})
+			if err := m.DidVersions[len(m.DidVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -14,6 +14,9 @@ const (
 	QuerierRoute = ModuleName
 
 	DidMethod = ModuleName
+
+	// EscrowAccountName is the name of the module account holding locked payments
+	EscrowAccountName = "cheqd_escrow"
 )
 
 func KeyPrefix(p string) []byte {
@@ -24,4 +27,31 @@ const (
 	DidKey          = "did:"
 	DidCountKey     = "did-count:"
 	DidNamespaceKey = "did-namespace:"
+	DidVersionKey   = "did-version:"
+	NamespaceKey    = "namespace:"
+
+	TrustedIssuerKey                 = "trusted-issuer:"
+	TrustedIssuerByCredentialTypeKey = "trusted-issuer-by-type:"
+
+	ReservedIdKey = "reserved-id:"
+
+	SchemaKey = "schema:"
+
+	CredDefKey         = "cred-def:"
+	CredDefByIssuerKey = "cred-def-by-issuer:"
+
+	RevocRegDefKey          = "revoc-reg-def:"
+	RevocRegEntryKey        = "revoc-reg-entry:"
+	RevocRegEntryHistoryKey = "revoc-reg-entry-history:"
+
+	StatusListKey      = "status-list:"
+	StatusListChunkKey = "status-list-chunk:"
+
+	ResourceKey              = "resource:"
+	ResourceHeaderKey        = "resource-header:"
+	ResourceLatestVersionKey = "resource-latest-version:"
+
+	EscrowKey              = "escrow:"
+	PaymentReceiptKey      = "payment-receipt:"
+	PaymentReceiptCountKey = "payment-receipt-count:"
 )
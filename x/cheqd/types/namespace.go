@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func NewNamespace(name string, status NamespaceStatus) Namespace {
+	return Namespace{
+		Name:   name,
+		Status: status,
+	}
+}
+
+// NewSelfCertifyingNamespace creates a namespace requiring unique ids to be derived from the initial authentication key
+func NewSelfCertifyingNamespace(name string, status NamespaceStatus) Namespace {
+	res := NewNamespace(name, status)
+	res.SelfCertifyingIds = true
+
+	return res
+}
+
+func (ns Namespace) IsActive() bool {
+	return ns.Status == NamespaceStatus_NAMESPACE_STATUS_ACTIVE
+}
+
+// CanTransitionTo checks whether the status of the namespace can be changed to the given one.
+// Retired namespaces can't be used again.
+func (ns Namespace) CanTransitionTo(status NamespaceStatus) error {
+	if ns.Status == NamespaceStatus_NAMESPACE_STATUS_RETIRED && status != NamespaceStatus_NAMESPACE_STATUS_RETIRED {
+		return fmt.Errorf("namespace %s is retired and can't be changed to %s", ns.Name, status)
+	}
+
+	return nil
+}
+
+// Helpers
+
+func GetNamespaceNames(namespaces []*Namespace) []string {
+	res := make([]string, len(namespaces))
+
+	for i := range namespaces {
+		res[i] = namespaces[i].Name
+	}
+
+	return res
+}
+
+// Validation
+
+func (ns Namespace) Validate() error {
+	return validation.ValidateStruct(&ns,
+		validation.Field(&ns.Name, IsNamespaceName()),
+		validation.Field(&ns.Status, validation.Required, validation.In(
+			NamespaceStatus_NAMESPACE_STATUS_ACTIVE,
+			NamespaceStatus_NAMESPACE_STATUS_READ_ONLY,
+			NamespaceStatus_NAMESPACE_STATUS_RETIRED,
+		)),
+	)
+}
+
+func ValidNamespaceRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(Namespace)
+		if !ok {
+			panic("ValidNamespaceRule must be only applied on namespaces")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func IsUniqueNamespaceListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*Namespace)
+		if !ok {
+			panic("IsUniqueNamespaceListRule must be only applied on namespace lists")
+		}
+
+		if !utils.IsUnique(GetNamespaceNames(casted)) {
+			return errors.New("there should be no duplicates")
+		}
+
+		return nil
+	})
+}
+
+func IsNamespaceName() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsNamespaceName must be only applied on string properties")
+		}
+
+		if !utils.DidNamespaceRegexp.MatchString(casted) {
+			return errors.New("namespace must contain only alphanumeric characters")
+		}
+
+		return nil
+	})
+}
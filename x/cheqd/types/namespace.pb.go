@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/namespace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NamespaceStatus defines which operations are allowed in a DID namespace.
+type NamespaceStatus int32
+
+const (
+	// NAMESPACE_STATUS_UNSPECIFIED is not a valid status
+	NamespaceStatus_NAMESPACE_STATUS_UNSPECIFIED NamespaceStatus = 0
+	// NAMESPACE_STATUS_ACTIVE allows creating and updating DIDs
+	NamespaceStatus_NAMESPACE_STATUS_ACTIVE NamespaceStatus = 1
+	// NAMESPACE_STATUS_READ_ONLY keeps existing DIDs resolvable, but doesn't allow creating or updating DIDs.
+	// The namespace can be activated again.
+	NamespaceStatus_NAMESPACE_STATUS_READ_ONLY NamespaceStatus = 2
+	// NAMESPACE_STATUS_RETIRED is the same as read-only, but the namespace can't be activated again
+	NamespaceStatus_NAMESPACE_STATUS_RETIRED NamespaceStatus = 3
+)
+
+var NamespaceStatus_name = map[int32]string{
+	0: "NAMESPACE_STATUS_UNSPECIFIED",
+	1: "NAMESPACE_STATUS_ACTIVE",
+	2: "NAMESPACE_STATUS_READ_ONLY",
+	3: "NAMESPACE_STATUS_RETIRED",
+}
+
+var NamespaceStatus_value = map[string]int32{
+	"NAMESPACE_STATUS_UNSPECIFIED": 0,
+	"NAMESPACE_STATUS_ACTIVE":      1,
+	"NAMESPACE_STATUS_READ_ONLY":   2,
+	"NAMESPACE_STATUS_RETIRED":     3,
+}
+
+func (x NamespaceStatus) String() string {
+	return proto.EnumName(NamespaceStatus_name, int32(x))
+}
+
+func (NamespaceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_31e20ee35c8af357, []int{0}
+}
+
+// Namespace is an entry of the DID namespace registry.
+type Namespace struct {
+	Name   string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status NamespaceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cheqdid.cheqdnode.cheqd.v1.NamespaceStatus" json:"status,omitempty"`
+	// If set, unique ids of new DIDs must be derived from their initial authentication key
+	SelfCertifyingIds bool `protobuf:"varint,3,opt,name=self_certifying_ids,json=selfCertifyingIds,proto3" json:"self_certifying_ids,omitempty"`
+}
+
+func (m *Namespace) Reset()         { *m = Namespace{} }
+func (m *Namespace) String() string { return proto.CompactTextString(m) }
+func (*Namespace) ProtoMessage()    {}
+func (*Namespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31e20ee35c8af357, []int{0}
+}
+func (m *Namespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Namespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Namespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Namespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Namespace.Merge(m, src)
+}
+func (m *Namespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *Namespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_Namespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Namespace proto.InternalMessageInfo
+
+func (m *Namespace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Namespace) GetStatus() NamespaceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return NamespaceStatus_NAMESPACE_STATUS_UNSPECIFIED
+}
+
+func (m *Namespace) GetSelfCertifyingIds() bool {
+	if m != nil {
+		return m.SelfCertifyingIds
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("cheqdid.cheqdnode.cheqd.v1.NamespaceStatus", NamespaceStatus_name, NamespaceStatus_value)
+	proto.RegisterType((*Namespace)(nil), "cheqdid.cheqdnode.cheqd.v1.Namespace")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/namespace.proto", fileDescriptor_31e20ee35c8af357) }
+
+var fileDescriptor_31e20ee35c8af357 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xfa, 0x30,
+	0x00, 0xc5, 0x57, 0xf8, 0x87, 0xfc, 0xe9, 0x41, 0x67, 0x3d, 0xb8, 0x20, 0x69, 0x16, 0x4f, 0xa8,
+	0xb1, 0x0b, 0xfa, 0x09, 0x66, 0xa9, 0xc9, 0x12, 0x9d, 0x64, 0x1b, 0x26, 0x7a, 0x59, 0x60, 0x2b,
+	0xb0, 0x44, 0x18, 0xd2, 0x42, 0xe4, 0x4b, 0x18, 0x0f, 0x7e, 0x28, 0x8f, 0x1c, 0x3d, 0x9a, 0xed,
+	0x8b, 0x98, 0x15, 0xe4, 0x20, 0x7a, 0x69, 0x5f, 0xfa, 0x7b, 0xef, 0x1d, 0xfa, 0xa0, 0x11, 0x0d,
+	0xf9, 0x53, 0x6c, 0xcd, 0x9b, 0xd6, 0xb8, 0x3b, 0xe2, 0x62, 0xd2, 0x8d, 0x38, 0x99, 0x4c, 0x53,
+	0x99, 0xa2, 0x9a, 0x22, 0x49, 0x4c, 0xd4, 0x3d, 0x4e, 0x63, 0xbe, 0x52, 0x64, 0xde, 0x3c, 0x7a,
+	0x03, 0xb0, 0xea, 0x7e, 0xfb, 0x11, 0x82, 0xff, 0x8a, 0xb0, 0x01, 0x4c, 0xd0, 0xa8, 0x7a, 0x4a,
+	0x23, 0x0a, 0x2b, 0x42, 0x76, 0xe5, 0x4c, 0x18, 0x25, 0x13, 0x34, 0x76, 0xce, 0x4f, 0xc9, 0xdf,
+	0x75, 0x64, 0x53, 0xe5, 0xab, 0x88, 0xb7, 0x8e, 0x22, 0x02, 0xf7, 0x05, 0x7f, 0xec, 0x87, 0x11,
+	0x9f, 0xca, 0xa4, 0xbf, 0x48, 0xc6, 0x83, 0x30, 0x89, 0x85, 0x51, 0x36, 0x41, 0xe3, 0xbf, 0xb7,
+	0x57, 0x20, 0xba, 0x21, 0x4e, 0x2c, 0x4e, 0x5e, 0x00, 0xdc, 0xfd, 0xd1, 0x85, 0x4c, 0x58, 0x77,
+	0xed, 0x1b, 0xe6, 0xb7, 0x6d, 0xca, 0x42, 0x3f, 0xb0, 0x83, 0x8e, 0x1f, 0x76, 0x5c, 0xbf, 0xcd,
+	0xa8, 0x73, 0xe5, 0xb0, 0x96, 0xae, 0xa1, 0x43, 0x78, 0xb0, 0xe5, 0xb0, 0x69, 0xe0, 0xdc, 0x31,
+	0x1d, 0x20, 0x0c, 0x6b, 0x5b, 0xd0, 0x63, 0x76, 0x2b, 0xbc, 0x75, 0xaf, 0xef, 0xf5, 0x12, 0xaa,
+	0x43, 0xe3, 0x17, 0x1e, 0x38, 0x1e, 0x6b, 0xe9, 0xe5, 0x4b, 0xfa, 0x9e, 0x61, 0xb0, 0xcc, 0x30,
+	0xf8, 0xcc, 0x30, 0x78, 0xcd, 0xb1, 0xb6, 0xcc, 0xb1, 0xf6, 0x91, 0x63, 0xed, 0xe1, 0x78, 0x90,
+	0xc8, 0xe1, 0xac, 0x47, 0xa2, 0x74, 0x64, 0xad, 0x26, 0x50, 0xe7, 0x59, 0xf1, 0x31, 0xd6, 0xf3,
+	0xfa, 0x49, 0x2e, 0x26, 0x5c, 0xf4, 0x2a, 0x6a, 0x8f, 0x8b, 0xaf, 0x01, 0x00, 0xe5, 0xda, 0x80,
+	0x2d, 0xab, 0x01, 0x00, 0x00,
+}
+
+func (m *Namespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SelfCertifyingIds {
+		i--
+		if m.SelfCertifyingIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintNamespace(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintNamespace(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNamespace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNamespace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Namespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNamespace(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovNamespace(uint64(m.Status))
+	}
+	if m.SelfCertifyingIds {
+		n += 2
+	}
+	return n
+}
+
+func sovNamespace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNamespace(x uint64) (n int) {
+	return sovNamespace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Namespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= NamespaceStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfCertifyingIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelfCertifyingIds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNamespace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNamespace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNamespace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNamespace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNamespace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNamespace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNamespace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNamespace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNamespace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNamespace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNamespace = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespaceValidation(t *testing.T) {
+	cases := []struct {
+		name      string
+		namespace Namespace
+		isValid   bool
+		errorMsg  string
+	}{
+		{
+			name:      "positive: active namespace",
+			namespace: NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_ACTIVE),
+			isValid:   true,
+		},
+		{
+			name:      "positive: retired namespace",
+			namespace: NewNamespace("testnet", NamespaceStatus_NAMESPACE_STATUS_RETIRED),
+			isValid:   true,
+		},
+		{
+			name:      "negative: invalid name",
+			namespace: NewNamespace("test-net", NamespaceStatus_NAMESPACE_STATUS_ACTIVE),
+			isValid:   false,
+			errorMsg:  "name: namespace must contain only alphanumeric characters.",
+		},
+		{
+			name:      "negative: unspecified status",
+			namespace: NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_UNSPECIFIED),
+			isValid:   false,
+			errorMsg:  "status: cannot be blank.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.namespace.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestNamespaceCanTransitionTo(t *testing.T) {
+	active := NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_ACTIVE)
+	require.NoError(t, active.CanTransitionTo(NamespaceStatus_NAMESPACE_STATUS_READ_ONLY))
+	require.NoError(t, active.CanTransitionTo(NamespaceStatus_NAMESPACE_STATUS_RETIRED))
+
+	readOnly := NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_READ_ONLY)
+	require.NoError(t, readOnly.CanTransitionTo(NamespaceStatus_NAMESPACE_STATUS_ACTIVE))
+
+	retired := NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_RETIRED)
+	require.NoError(t, retired.CanTransitionTo(NamespaceStatus_NAMESPACE_STATUS_RETIRED))
+	require.EqualError(t, retired.CanTransitionTo(NamespaceStatus_NAMESPACE_STATUS_ACTIVE), "namespace mainnet is retired and can't be changed to NAMESPACE_STATUS_ACTIVE")
+}
+
+func TestSetNamespacesProposalValidation(t *testing.T) {
+	mainnet := NewNamespace("mainnet", NamespaceStatus_NAMESPACE_STATUS_ACTIVE)
+
+	require.NoError(t, NewSetNamespacesProposal("title", "description", []*Namespace{&mainnet}).ValidateBasic())
+	require.Error(t, NewSetNamespacesProposal("", "description", []*Namespace{&mainnet}).ValidateBasic())
+	require.EqualError(t, NewSetNamespacesProposal("title", "description", nil).ValidateBasic(), "namespaces: cannot be blank.: basic validation failed")
+	require.EqualError(t, NewSetNamespacesProposal("title", "description", []*Namespace{&mainnet, &mainnet}).ValidateBasic(), "namespaces: there should be no duplicates.: basic validation failed")
+}
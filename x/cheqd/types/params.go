@@ -0,0 +1,258 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	DefaultMaxVerificationMethods uint64 = 64
+	DefaultMaxServices            uint64 = 64
+	DefaultMaxControllers         uint64 = 64
+	DefaultMaxPayloadBytes        uint64 = 64 * 1024
+
+	// MaxTypeNameLength limits verification method and service type names
+	MaxTypeNameLength = 128
+)
+
+var (
+	KeyRequireProofOfPossession  = []byte("RequireProofOfPossession")
+	KeyLegacySignaturesEndHeight = []byte("LegacySignaturesEndHeight")
+	KeyMaxVerificationMethods    = []byte("MaxVerificationMethods")
+	KeyMaxServices               = []byte("MaxServices")
+	KeyMaxControllers            = []byte("MaxControllers")
+	KeyMaxPayloadBytes           = []byte("MaxPayloadBytes")
+	KeyAllowedMethodTypes        = []byte("AllowedMethodTypes")
+	KeyAllowedServiceTypes       = []byte("AllowedServiceTypes")
+	KeyFeeParams                 = []byte("FeeParams")
+	KeyGasParams                 = []byte("GasParams")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the key table for the cheqd module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	requireProofOfPossession bool,
+	legacySignaturesEndHeight uint64,
+	maxVerificationMethods uint64,
+	maxServices uint64,
+	maxControllers uint64,
+	maxPayloadBytes uint64,
+	allowedMethodTypes []string,
+	allowedServiceTypes []string,
+	feeParams FeeParams,
+	gasParams GasParams,
+) Params {
+	return Params{
+		RequireProofOfPossession:  requireProofOfPossession,
+		LegacySignaturesEndHeight: legacySignaturesEndHeight,
+		MaxVerificationMethods:    maxVerificationMethods,
+		MaxServices:               maxServices,
+		MaxControllers:            maxControllers,
+		MaxPayloadBytes:           maxPayloadBytes,
+		AllowedMethodTypes:        allowedMethodTypes,
+		AllowedServiceTypes:       allowedServiceTypes,
+		FeeParams:                 &feeParams,
+		GasParams:                 &gasParams,
+	}
+}
+
+// DefaultParams returns default cheqd module parameters
+func DefaultParams() Params {
+	return NewParams(
+		false,
+		0,
+		DefaultMaxVerificationMethods,
+		DefaultMaxServices,
+		DefaultMaxControllers,
+		DefaultMaxPayloadBytes,
+		append([]string{}, SupportedMethodTypes...),
+		append([]string{}, SupportedServiceTypes...),
+		DefaultFeeParams(),
+		DefaultGasParams(),
+	)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRequireProofOfPossession, &p.RequireProofOfPossession, validateBool),
+		paramtypes.NewParamSetPair(KeyLegacySignaturesEndHeight, &p.LegacySignaturesEndHeight, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxVerificationMethods, &p.MaxVerificationMethods, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxServices, &p.MaxServices, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxControllers, &p.MaxControllers, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyMaxPayloadBytes, &p.MaxPayloadBytes, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyAllowedMethodTypes, &p.AllowedMethodTypes, validateAllowedMethodTypes),
+		paramtypes.NewParamSetPair(KeyAllowedServiceTypes, &p.AllowedServiceTypes, validateAllowedServiceTypes),
+		paramtypes.NewParamSetPair(KeyFeeParams, &p.FeeParams, validateFeeParams),
+		paramtypes.NewParamSetPair(KeyGasParams, &p.GasParams, validateGasParams),
+	}
+}
+
+// Enforcement
+
+// ValidateDid checks that a DID Doc fits into the limits defined by the params
+func (p Params) ValidateDid(did *Did) error {
+	if uint64(len(did.VerificationMethod)) > p.MaxVerificationMethods {
+		return fmt.Errorf("number of verification methods %d exceeds the limit of %d", len(did.VerificationMethod), p.MaxVerificationMethods)
+	}
+
+	if uint64(len(did.Service)) > p.MaxServices {
+		return fmt.Errorf("number of services %d exceeds the limit of %d", len(did.Service), p.MaxServices)
+	}
+
+	if uint64(len(did.Controller)) > p.MaxControllers {
+		return fmt.Errorf("number of controllers %d exceeds the limit of %d", len(did.Controller), p.MaxControllers)
+	}
+
+	for _, vm := range did.VerificationMethod {
+		if !utils.Contains(p.AllowedMethodTypes, vm.Type) {
+			return fmt.Errorf("%s: verification method type %s is not allowed", vm.Id, vm.Type)
+		}
+	}
+
+	for _, s := range did.Service {
+		if !utils.Contains(p.AllowedServiceTypes, s.Type) {
+			return fmt.Errorf("%s: service type %s is not allowed", s.Id, s.Type)
+		}
+	}
+
+	return nil
+}
+
+// ValidatePayloadSize checks that a serialised identity message payload fits into the limit defined by the params
+func (p Params) ValidatePayloadSize(payload []byte) error {
+	if uint64(len(payload)) > p.MaxPayloadBytes {
+		return fmt.Errorf("payload size %d exceeds the limit of %d bytes", len(payload), p.MaxPayloadBytes)
+	}
+
+	return nil
+}
+
+// Validation
+
+func (p Params) Validate() error {
+	if err := validateBool(p.RequireProofOfPossession); err != nil {
+		return err
+	}
+
+	if err := validateUint64(p.LegacySignaturesEndHeight); err != nil {
+		return err
+	}
+
+	for _, limit := range []uint64{p.MaxVerificationMethods, p.MaxServices, p.MaxControllers, p.MaxPayloadBytes} {
+		if err := validatePositiveUint64(limit); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAllowedMethodTypes(p.AllowedMethodTypes); err != nil {
+		return err
+	}
+
+	if err := validateAllowedServiceTypes(p.AllowedServiceTypes); err != nil {
+		return err
+	}
+
+	if err := validateFeeParams(p.FeeParams); err != nil {
+		return err
+	}
+
+	return validateGasParams(p.GasParams)
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("parameter must be positive")
+	}
+
+	return nil
+}
+
+// validateAllowedMethodTypes checks that only method types with implemented signature verification are allowed
+func validateAllowedMethodTypes(i interface{}) error {
+	if err := validateTypeList(i, "verification method type"); err != nil {
+		return err
+	}
+
+	if unsupported := utils.Subtract(i.([]string), SupportedMethodTypes); len(unsupported) != 0 {
+		return fmt.Errorf("unsupported verification method type: %s", unsupported[0])
+	}
+
+	return nil
+}
+
+// validateAllowedServiceTypes checks only the format, so governance can allow new service types
+func validateAllowedServiceTypes(i interface{}) error {
+	return validateTypeList(i, "service type")
+}
+
+func validateTypeList(i interface{}, name string) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return fmt.Errorf("at least one %s must be allowed", name)
+	}
+
+	if !utils.IsUnique(v) {
+		return fmt.Errorf("allowed %ss must be unique", name)
+	}
+
+	for _, type_ := range v {
+		if err := validateTypeName(type_); err != nil {
+			return fmt.Errorf("invalid %s %q: %s", name, type_, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func validateTypeName(type_ string) error {
+	if type_ == "" {
+		return errors.New("must not be empty")
+	}
+
+	if len(type_) > MaxTypeNameLength {
+		return fmt.Errorf("must not be longer than %d bytes", MaxTypeNameLength)
+	}
+
+	if strings.TrimSpace(type_) != type_ {
+		return errors.New("must not have leading or trailing spaces")
+	}
+
+	return nil
+}
@@ -0,0 +1,1502 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the cheqd module.
+type Params struct {
+	// require_proof_of_possession makes every newly added signing-capable verification method sign the payload
+	RequireProofOfPossession bool `protobuf:"varint,1,opt,name=require_proof_of_possession,json=requireProofOfPossession,proto3" json:"require_proof_of_possession,omitempty"`
+	// legacy_signatures_end_height is the last block height at which signatures over raw payload bytes are accepted.
+	// 0 means that the transition window is not closed yet.
+	LegacySignaturesEndHeight uint64 `protobuf:"varint,2,opt,name=legacy_signatures_end_height,json=legacySignaturesEndHeight,proto3" json:"legacy_signatures_end_height,omitempty"`
+	// max_verification_methods is the maximum number of verification methods in a DID Doc
+	MaxVerificationMethods uint64 `protobuf:"varint,3,opt,name=max_verification_methods,json=maxVerificationMethods,proto3" json:"max_verification_methods,omitempty"`
+	// max_services is the maximum number of services in a DID Doc
+	MaxServices uint64 `protobuf:"varint,4,opt,name=max_services,json=maxServices,proto3" json:"max_services,omitempty"`
+	// max_controllers is the maximum number of controllers of a DID Doc
+	MaxControllers uint64 `protobuf:"varint,5,opt,name=max_controllers,json=maxControllers,proto3" json:"max_controllers,omitempty"`
+	// max_payload_bytes is the maximum size of a serialised identity message payload
+	MaxPayloadBytes uint64 `protobuf:"varint,6,opt,name=max_payload_bytes,json=maxPayloadBytes,proto3" json:"max_payload_bytes,omitempty"`
+	// allowed_method_types is the subset of supported verification method types that can be used
+	AllowedMethodTypes []string `protobuf:"bytes,7,rep,name=allowed_method_types,json=allowedMethodTypes,proto3" json:"allowed_method_types,omitempty"`
+	// allowed_service_types are the service types that can be used. Any non-empty type up to 128 bytes without
+	// leading or trailing spaces can be allowed, including types the module doesn't know about
+	AllowedServiceTypes []string `protobuf:"bytes,8,rep,name=allowed_service_types,json=allowedServiceTypes,proto3" json:"allowed_service_types,omitempty"`
+	// fee_params defines fixed identity fees charged on top of gas
+	FeeParams *FeeParams `protobuf:"bytes,9,opt,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// gas_params defines gas consumed by identity operations on top of KV store access
+	GasParams *GasParams `protobuf:"bytes,10,opt,name=gas_params,json=gasParams,proto3" json:"gas_params,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRequireProofOfPossession() bool {
+	if m != nil {
+		return m.RequireProofOfPossession
+	}
+	return false
+}
+
+func (m *Params) GetLegacySignaturesEndHeight() uint64 {
+	if m != nil {
+		return m.LegacySignaturesEndHeight
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVerificationMethods() uint64 {
+	if m != nil {
+		return m.MaxVerificationMethods
+	}
+	return 0
+}
+
+func (m *Params) GetMaxServices() uint64 {
+	if m != nil {
+		return m.MaxServices
+	}
+	return 0
+}
+
+func (m *Params) GetMaxControllers() uint64 {
+	if m != nil {
+		return m.MaxControllers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPayloadBytes() uint64 {
+	if m != nil {
+		return m.MaxPayloadBytes
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedMethodTypes() []string {
+	if m != nil {
+		return m.AllowedMethodTypes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedServiceTypes() []string {
+	if m != nil {
+		return m.AllowedServiceTypes
+	}
+	return nil
+}
+
+func (m *Params) GetFeeParams() *FeeParams {
+	if m != nil {
+		return m.FeeParams
+	}
+	return nil
+}
+
+func (m *Params) GetGasParams() *GasParams {
+	if m != nil {
+		return m.GasParams
+	}
+	return nil
+}
+
+// FeeParams defines fixed fees for identity operations and how they are distributed.
+// The part of a fee that is neither burnt nor sent to the community pool goes to the fee collector.
+type FeeParams struct {
+	// create_did is the fee for creating a DID Doc
+	CreateDid *types.Coin `protobuf:"bytes,1,opt,name=create_did,json=createDid,proto3" json:"create_did,omitempty"`
+	// update_did is the fee for updating or patching a DID Doc
+	UpdateDid *types.Coin `protobuf:"bytes,2,opt,name=update_did,json=updateDid,proto3" json:"update_did,omitempty"`
+	// deactivate_did is the fee for submitting a proposal to deactivate a DID Doc
+	DeactivateDid *types.Coin `protobuf:"bytes,3,opt,name=deactivate_did,json=deactivateDid,proto3" json:"deactivate_did,omitempty"`
+	// burn_factor is the share of a fee that is burnt, a decimal in [0, 1]
+	BurnFactor string `protobuf:"bytes,4,opt,name=burn_factor,json=burnFactor,proto3" json:"burn_factor,omitempty"`
+	// community_pool_factor is the share of a fee that is sent to the community pool, a decimal in [0, 1]
+	CommunityPoolFactor string `protobuf:"bytes,5,opt,name=community_pool_factor,json=communityPoolFactor,proto3" json:"community_pool_factor,omitempty"`
+}
+
+func (m *FeeParams) Reset()         { *m = FeeParams{} }
+func (m *FeeParams) String() string { return proto.CompactTextString(m) }
+func (*FeeParams) ProtoMessage()    {}
+func (*FeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{1}
+}
+func (m *FeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeParams.Merge(m, src)
+}
+func (m *FeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeParams proto.InternalMessageInfo
+
+func (m *FeeParams) GetCreateDid() *types.Coin {
+	if m != nil {
+		return m.CreateDid
+	}
+	return nil
+}
+
+func (m *FeeParams) GetUpdateDid() *types.Coin {
+	if m != nil {
+		return m.UpdateDid
+	}
+	return nil
+}
+
+func (m *FeeParams) GetDeactivateDid() *types.Coin {
+	if m != nil {
+		return m.DeactivateDid
+	}
+	return nil
+}
+
+func (m *FeeParams) GetBurnFactor() string {
+	if m != nil {
+		return m.BurnFactor
+	}
+	return ""
+}
+
+func (m *FeeParams) GetCommunityPoolFactor() string {
+	if m != nil {
+		return m.CommunityPoolFactor
+	}
+	return ""
+}
+
+// GasParams defines gas costs of identity operations, similar to the signature verification costs of x/auth.
+type GasParams struct {
+	// sig_verify_cost_ed25519 is the cost of an Ed25519 signature verification
+	SigVerifyCostEd25519 uint64 `protobuf:"varint,1,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
+	// sig_verify_cost_secp256k1 is the cost of a secp256k1 signature verification
+	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,2,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// sig_verify_cost_secp256r1 is the cost of a P-256 signature verification
+	SigVerifyCostSecp256R1 uint64 `protobuf:"varint,3,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty"`
+	// sig_verify_cost_rsa is the cost of an RSA signature verification
+	SigVerifyCostRsa uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_rsa,json=sigVerifyCostRsa,proto3" json:"sig_verify_cost_rsa,omitempty"`
+	// controller_resolution_cost is the cost of a DID resolution performed to find controllers and signers
+	ControllerResolutionCost uint64 `protobuf:"varint,5,opt,name=controller_resolution_cost,json=controllerResolutionCost,proto3" json:"controller_resolution_cost,omitempty"`
+	// document_byte_cost is the cost per byte of a created or updated DID Doc
+	DocumentByteCost uint64 `protobuf:"varint,6,opt,name=document_byte_cost,json=documentByteCost,proto3" json:"document_byte_cost,omitempty"`
+}
+
+func (m *GasParams) Reset()         { *m = GasParams{} }
+func (m *GasParams) String() string { return proto.CompactTextString(m) }
+func (*GasParams) ProtoMessage()    {}
+func (*GasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c4e8b0b9dda0170, []int{2}
+}
+func (m *GasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasParams.Merge(m, src)
+}
+func (m *GasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasParams proto.InternalMessageInfo
+
+func (m *GasParams) GetSigVerifyCostEd25519() uint64 {
+	if m != nil {
+		return m.SigVerifyCostEd25519
+	}
+	return 0
+}
+
+func (m *GasParams) GetSigVerifyCostSecp256K1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256K1
+	}
+	return 0
+}
+
+func (m *GasParams) GetSigVerifyCostSecp256R1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256R1
+	}
+	return 0
+}
+
+func (m *GasParams) GetSigVerifyCostRsa() uint64 {
+	if m != nil {
+		return m.SigVerifyCostRsa
+	}
+	return 0
+}
+
+func (m *GasParams) GetControllerResolutionCost() uint64 {
+	if m != nil {
+		return m.ControllerResolutionCost
+	}
+	return 0
+}
+
+func (m *GasParams) GetDocumentByteCost() uint64 {
+	if m != nil {
+		return m.DocumentByteCost
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cheqdid.cheqdnode.cheqd.v1.Params")
+	proto.RegisterType((*FeeParams)(nil), "cheqdid.cheqdnode.cheqd.v1.FeeParams")
+	proto.RegisterType((*GasParams)(nil), "cheqdid.cheqdnode.cheqd.v1.GasParams")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/params.proto", fileDescriptor_5c4e8b0b9dda0170) }
+
+var fileDescriptor_5c4e8b0b9dda0170 = []byte{
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x5d, 0x6f, 0xd3, 0x3a,
+	0x1c, 0xc6, 0xd7, 0x97, 0xed, 0xac, 0xee, 0x39, 0x3b, 0x3b, 0xde, 0xcb, 0xc9, 0x06, 0x2a, 0x65,
+	0x12, 0xa2, 0x20, 0x96, 0x92, 0xa2, 0xa2, 0x4d, 0x02, 0x81, 0xd6, 0x6d, 0x70, 0x83, 0xa8, 0x32,
+	0xc4, 0x05, 0x37, 0x96, 0x1b, 0xbb, 0xa9, 0x45, 0x12, 0x67, 0xb6, 0x53, 0xda, 0x6f, 0xc1, 0x15,
+	0x9f, 0x82, 0x0f, 0xc2, 0xe5, 0x24, 0x6e, 0xb8, 0x44, 0xdb, 0x17, 0x41, 0xb6, 0x93, 0x96, 0x4d,
+	0x1a, 0xbb, 0x69, 0xa3, 0xff, 0xf3, 0xfc, 0x1e, 0x59, 0xf1, 0xff, 0x09, 0xd8, 0x08, 0x46, 0xf4,
+	0x94, 0xb4, 0xc7, 0x5e, 0x3b, 0xc5, 0x02, 0xc7, 0xd2, 0x4d, 0x05, 0x57, 0x1c, 0x6e, 0x9b, 0x31,
+	0x23, 0xae, 0xf9, 0x4f, 0x38, 0xa1, 0xf6, 0xc9, 0x1d, 0x7b, 0xdb, 0x8d, 0x80, 0xcb, 0x98, 0xcb,
+	0xf6, 0x00, 0x4b, 0xda, 0x1e, 0x7b, 0x03, 0xaa, 0xb0, 0xd7, 0x0e, 0x38, 0x4b, 0x2c, 0xbb, 0xf3,
+	0xb5, 0x0a, 0x96, 0xfa, 0x26, 0x0c, 0x3e, 0x07, 0xb7, 0x04, 0x3d, 0xcd, 0x98, 0xa0, 0x28, 0x15,
+	0x9c, 0x0f, 0x11, 0x1f, 0xa2, 0x94, 0x4b, 0x49, 0xa5, 0x64, 0x3c, 0x71, 0x4a, 0xcd, 0x52, 0x6b,
+	0xd9, 0x77, 0x72, 0x4b, 0x5f, 0x3b, 0xde, 0x0e, 0xfb, 0x33, 0x1d, 0xbe, 0x00, 0xb7, 0x23, 0x1a,
+	0xe2, 0x60, 0x8a, 0x24, 0x0b, 0x13, 0xac, 0x32, 0x41, 0x25, 0xa2, 0x09, 0x41, 0x23, 0xca, 0xc2,
+	0x91, 0x72, 0xca, 0xcd, 0x52, 0xab, 0xea, 0x6f, 0x59, 0xcf, 0xc9, 0xcc, 0x72, 0x94, 0x90, 0xd7,
+	0xc6, 0x00, 0xf7, 0x80, 0x13, 0xe3, 0x09, 0x1a, 0x53, 0xc1, 0x86, 0x2c, 0xc0, 0x8a, 0xf1, 0x04,
+	0xc5, 0x54, 0x8d, 0x38, 0x91, 0x4e, 0xc5, 0xc0, 0x9b, 0x31, 0x9e, 0xbc, 0xff, 0x4d, 0x7e, 0x63,
+	0x55, 0x78, 0x17, 0xfc, 0xad, 0x49, 0x49, 0xc5, 0x98, 0x05, 0x54, 0x3a, 0x55, 0xe3, 0xae, 0xc7,
+	0x78, 0x72, 0x92, 0x8f, 0xe0, 0x7d, 0xf0, 0xaf, 0xb6, 0x04, 0x3c, 0x51, 0x82, 0x47, 0x11, 0x15,
+	0xd2, 0x59, 0x34, 0xae, 0x95, 0x18, 0x4f, 0x7a, 0xf3, 0x29, 0x7c, 0x08, 0xfe, 0xd3, 0xc6, 0x14,
+	0x4f, 0x23, 0x8e, 0x09, 0x1a, 0x4c, 0x15, 0x95, 0xce, 0x92, 0xb1, 0xea, 0x84, 0xbe, 0x9d, 0x1f,
+	0xe8, 0x31, 0x7c, 0x0c, 0xd6, 0x71, 0x14, 0xf1, 0x4f, 0x94, 0xe4, 0x07, 0x45, 0x6a, 0x9a, 0x52,
+	0xe9, 0xfc, 0xd5, 0xac, 0xb4, 0x6a, 0x3e, 0xcc, 0x35, 0x7b, 0xca, 0x77, 0x5a, 0x81, 0x1d, 0xb0,
+	0x51, 0x10, 0xf9, 0x69, 0x73, 0x64, 0xd9, 0x20, 0x6b, 0xb9, 0x98, 0x1f, 0xdb, 0x32, 0x87, 0x00,
+	0x0c, 0x29, 0x45, 0xf6, 0xca, 0x9d, 0x5a, 0xb3, 0xd4, 0xaa, 0x77, 0xee, 0xb9, 0xd7, 0xdf, 0xb9,
+	0x7b, 0x4c, 0xa9, 0xbd, 0x52, 0xbf, 0x36, 0x2c, 0x1e, 0x75, 0x4a, 0x88, 0x65, 0x91, 0x02, 0x6e,
+	0x4e, 0x79, 0x85, 0x65, 0x91, 0x12, 0x16, 0x8f, 0x3b, 0x5f, 0xca, 0xa0, 0x36, 0x8b, 0x87, 0x7b,
+	0x00, 0x04, 0x82, 0x62, 0x45, 0x11, 0x61, 0xc4, 0x2c, 0x48, 0xbd, 0xb3, 0xe5, 0xda, 0x8d, 0x73,
+	0xf5, 0xc6, 0xb9, 0xf9, 0xc6, 0xb9, 0x3d, 0xce, 0x12, 0xbf, 0x66, 0xcd, 0x87, 0x8c, 0x68, 0x32,
+	0x4b, 0x49, 0x41, 0x96, 0x6f, 0x24, 0xad, 0x59, 0x93, 0x2f, 0xc1, 0x0a, 0xa1, 0x38, 0x50, 0x6c,
+	0x5c, 0xd0, 0x95, 0x9b, 0xe8, 0x7f, 0xe6, 0x80, 0x4e, 0xb8, 0x03, 0xea, 0x83, 0x4c, 0x24, 0x68,
+	0x88, 0x03, 0xc5, 0x85, 0x59, 0x96, 0x9a, 0x0f, 0xf4, 0xe8, 0xd8, 0x4c, 0xf4, 0x25, 0x05, 0x3c,
+	0x8e, 0xb3, 0x84, 0xa9, 0x29, 0x4a, 0x39, 0x8f, 0x0a, 0xeb, 0xa2, 0xb1, 0xae, 0xcd, 0xc4, 0x3e,
+	0xe7, 0x91, 0x65, 0x76, 0xbe, 0x97, 0x41, 0x6d, 0xf6, 0xc6, 0x60, 0x17, 0xfc, 0x2f, 0x59, 0x68,
+	0x57, 0x79, 0x8a, 0x02, 0x2e, 0x15, 0xa2, 0xa4, 0xd3, 0xed, 0x7a, 0xfb, 0xe6, 0x2d, 0x55, 0xfd,
+	0x75, 0xc9, 0x42, 0xb3, 0xc9, 0xd3, 0x1e, 0x97, 0xea, 0xc8, 0x6a, 0x70, 0x1f, 0x6c, 0x5d, 0xc5,
+	0x24, 0x0d, 0xd2, 0x4e, 0xf7, 0xe9, 0x47, 0x2f, 0xef, 0xcf, 0xe6, 0x25, 0xf0, 0xa4, 0x50, 0xff,
+	0x80, 0x0a, 0xcf, 0xa9, 0x5c, 0x8f, 0x0a, 0x0f, 0xee, 0x82, 0xb5, 0xab, 0xa8, 0x90, 0x38, 0x2f,
+	0xd1, 0xea, 0x25, 0xc8, 0x97, 0x18, 0x3e, 0x03, 0xdb, 0xf3, 0x16, 0x21, 0x41, 0x25, 0x8f, 0x32,
+	0xd3, 0x55, 0x4d, 0xe6, 0xa5, 0x72, 0xe6, 0x0e, 0x7f, 0x66, 0xd0, 0x01, 0xf0, 0x11, 0x80, 0x84,
+	0x07, 0x59, 0x4c, 0x13, 0x65, 0xba, 0x65, 0x29, 0xdb, 0xaf, 0xd5, 0x42, 0xd1, 0xed, 0xd2, 0xee,
+	0x83, 0xde, 0xb7, 0xf3, 0x46, 0xe9, 0xec, 0xbc, 0x51, 0xfa, 0x79, 0xde, 0x28, 0x7d, 0xbe, 0x68,
+	0x2c, 0x9c, 0x5d, 0x34, 0x16, 0x7e, 0x5c, 0x34, 0x16, 0x3e, 0x3c, 0x08, 0x99, 0x1a, 0x65, 0x03,
+	0x37, 0xe0, 0x71, 0xdb, 0x7e, 0x15, 0xcd, 0xef, 0xae, 0xde, 0xe1, 0xf6, 0x24, 0x1f, 0x99, 0x6a,
+	0x0d, 0x96, 0xcc, 0x97, 0xee, 0xc9, 0xaf, 0x01, 0x00, 0x91, 0xcc, 0x0d, 0xca, 0x3e, 0x05, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasParams != nil {
+		{
+			size, err := m.GasParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.FeeParams != nil {
+		{
+			size, err := m.FeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AllowedServiceTypes) > 0 {
+		for iNdEx := len(m.AllowedServiceTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedServiceTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedServiceTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedServiceTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedMethodTypes) > 0 {
+		for iNdEx := len(m.AllowedMethodTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMethodTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMethodTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMethodTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxPayloadBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPayloadBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxControllers != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxControllers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxServices != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxServices))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxVerificationMethods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxVerificationMethods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LegacySignaturesEndHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LegacySignaturesEndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequireProofOfPossession {
+		i--
+		if m.RequireProofOfPossession {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPoolFactor) > 0 {
+		i -= len(m.CommunityPoolFactor)
+		copy(dAtA[i:], m.CommunityPoolFactor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CommunityPoolFactor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BurnFactor) > 0 {
+		i -= len(m.BurnFactor)
+		copy(dAtA[i:], m.BurnFactor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BurnFactor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeactivateDid != nil {
+		{
+			size, err := m.DeactivateDid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdateDid != nil {
+		{
+			size, err := m.UpdateDid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CreateDid != nil {
+		{
+			size, err := m.CreateDid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DocumentByteCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DocumentByteCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ControllerResolutionCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ControllerResolutionCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SigVerifyCostRsa != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SigVerifyCostRsa))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SigVerifyCostSecp256R1 != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SigVerifyCostSecp256R1))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SigVerifyCostSecp256K1 != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SigVerifyCostSecp256K1))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SigVerifyCostEd25519 != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SigVerifyCostEd25519))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequireProofOfPossession {
+		n += 2
+	}
+	if m.LegacySignaturesEndHeight != 0 {
+		n += 1 + sovParams(uint64(m.LegacySignaturesEndHeight))
+	}
+	if m.MaxVerificationMethods != 0 {
+		n += 1 + sovParams(uint64(m.MaxVerificationMethods))
+	}
+	if m.MaxServices != 0 {
+		n += 1 + sovParams(uint64(m.MaxServices))
+	}
+	if m.MaxControllers != 0 {
+		n += 1 + sovParams(uint64(m.MaxControllers))
+	}
+	if m.MaxPayloadBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxPayloadBytes))
+	}
+	if len(m.AllowedMethodTypes) > 0 {
+		for _, s := range m.AllowedMethodTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedServiceTypes) > 0 {
+		for _, s := range m.AllowedServiceTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.FeeParams != nil {
+		l = m.FeeParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasParams != nil {
+		l = m.GasParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateDid != nil {
+		l = m.CreateDid.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.UpdateDid != nil {
+		l = m.UpdateDid.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.DeactivateDid != nil {
+		l = m.DeactivateDid.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BurnFactor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CommunityPoolFactor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *GasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigVerifyCostEd25519 != 0 {
+		n += 1 + sovParams(uint64(m.SigVerifyCostEd25519))
+	}
+	if m.SigVerifyCostSecp256K1 != 0 {
+		n += 1 + sovParams(uint64(m.SigVerifyCostSecp256K1))
+	}
+	if m.SigVerifyCostSecp256R1 != 0 {
+		n += 1 + sovParams(uint64(m.SigVerifyCostSecp256R1))
+	}
+	if m.SigVerifyCostRsa != 0 {
+		n += 1 + sovParams(uint64(m.SigVerifyCostRsa))
+	}
+	if m.ControllerResolutionCost != 0 {
+		n += 1 + sovParams(uint64(m.ControllerResolutionCost))
+	}
+	if m.DocumentByteCost != 0 {
+		n += 1 + sovParams(uint64(m.DocumentByteCost))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireProofOfPossession", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireProofOfPossession = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacySignaturesEndHeight", wireType)
+			}
+			m.LegacySignaturesEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacySignaturesEndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVerificationMethods", wireType)
+			}
+			m.MaxVerificationMethods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVerificationMethods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxServices", wireType)
+			}
+			m.MaxServices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxServices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxControllers", wireType)
+			}
+			m.MaxControllers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxControllers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadBytes", wireType)
+			}
+			m.MaxPayloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMethodTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMethodTypes = append(m.AllowedMethodTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedServiceTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedServiceTypes = append(m.AllowedServiceTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeParams == nil {
+				m.FeeParams = &FeeParams{}
+			}
+			if err := m.FeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasParams == nil {
+				m.GasParams = &GasParams{}
+			}
+			if err := m.GasParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateDid == nil {
+				m.CreateDid = &types.Coin{}
+			}
+			if err := m.CreateDid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateDid == nil {
+				m.UpdateDid = &types.Coin{}
+			}
+			if err := m.UpdateDid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivateDid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeactivateDid == nil {
+				m.DeactivateDid = &types.Coin{}
+			}
+			if err := m.DeactivateDid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostEd25519", wireType)
+			}
+			m.SigVerifyCostEd25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostEd25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256K1", wireType)
+			}
+			m.SigVerifyCostSecp256K1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256K1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256R1", wireType)
+			}
+			m.SigVerifyCostSecp256R1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256R1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostRsa", wireType)
+			}
+			m.SigVerifyCostRsa = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostRsa |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerResolutionCost", wireType)
+			}
+			m.ControllerResolutionCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerResolutionCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentByteCost", wireType)
+			}
+			m.DocumentByteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DocumentByteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
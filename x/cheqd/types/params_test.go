@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		update   func(params *Params)
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: default params",
+			update:  func(params *Params) {},
+			isValid: true,
+		},
+		{
+			name:    "positive: subset of supported method types",
+			update:  func(params *Params) { params.AllowedMethodTypes = []string{JsonWebKey2020} },
+			isValid: true,
+		},
+		{
+			name:     "negative: zero limit",
+			update:   func(params *Params) { params.MaxControllers = 0 },
+			isValid:  false,
+			errorMsg: "parameter must be positive",
+		},
+		{
+			name:     "negative: no allowed method types",
+			update:   func(params *Params) { params.AllowedMethodTypes = nil },
+			isValid:  false,
+			errorMsg: "at least one verification method type must be allowed",
+		},
+		{
+			name:    "positive: new service type",
+			update:  func(params *Params) { params.AllowedServiceTypes = []string{"LinkedDomains", "CredentialRegistry"} },
+			isValid: true,
+		},
+		{
+			name:     "negative: unsupported method type",
+			update:   func(params *Params) { params.AllowedMethodTypes = []string{JsonWebKey2020, "Unknown"} },
+			isValid:  false,
+			errorMsg: "unsupported verification method type: Unknown",
+		},
+		{
+			name:     "negative: empty service type",
+			update:   func(params *Params) { params.AllowedServiceTypes = []string{"LinkedDomains", ""} },
+			isValid:  false,
+			errorMsg: "invalid service type \"\": must not be empty",
+		},
+		{
+			name:     "negative: too long service type",
+			update:   func(params *Params) { params.AllowedServiceTypes = []string{strings.Repeat("a", MaxTypeNameLength+1)} },
+			isValid:  false,
+			errorMsg: fmt.Sprintf("invalid service type \"%s\": must not be longer than 128 bytes", strings.Repeat("a", MaxTypeNameLength+1)),
+		},
+		{
+			name:     "negative: duplicated service types",
+			update:   func(params *Params) { params.AllowedServiceTypes = []string{"LinkedDomains", "LinkedDomains"} },
+			isValid:  false,
+			errorMsg: "allowed service types must be unique",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.update(&params)
+			err := params.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// PaymentReceiptsPerPayerMax is the maximum number of receipts recorded for a payer and a request id.
+// Further sends are executed, but aren't recorded. Other payers can't use up the limit of the payer.
+const PaymentReceiptsPerPayerMax = 10
+
+type paymentRequestIdKey struct{}
+
+// WithPaymentRequestId stores the request id of the transaction memo in the context, see GetPaymentRequestId
+func WithPaymentRequestId(ctx sdk.Context, requestId string) sdk.Context {
+	return ctx.WithValue(paymentRequestIdKey{}, requestId)
+}
+
+// GetPaymentRequestIdFromContext returns the request id of the transaction being executed
+func GetPaymentRequestIdFromContext(ctx sdk.Context) (string, bool) {
+	requestId, ok := ctx.Value(paymentRequestIdKey{}).(string)
+	return requestId, ok && requestId != ""
+}
+
+func NewPaymentReceipt(requestId string, payer string, payee string, amount sdk.Coins, height int64, txHash string) PaymentReceipt {
+	var coins []*sdk.Coin
+	for _, coin := range amount {
+		coin := coin
+		coins = append(coins, &coin)
+	}
+
+	return PaymentReceipt{
+		RequestId: requestId,
+		Payer:     payer,
+		Payee:     payee,
+		Amount:    coins,
+		Height:    height,
+		TxHash:    txHash,
+	}
+}
+
+// GetPaymentRequestId returns the request id if the transaction memo follows the ADR-001 payment format,
+// i.e. the memo is the id of the payment request, which is a UUID
+func GetPaymentRequestId(memo string) (string, bool) {
+	memo = strings.TrimSpace(memo)
+	if !utils.IsUUID(memo) {
+		return "", false
+	}
+
+	return strings.ToLower(memo), true
+}
+
+// GetCoins returns the paid amount
+func (receipt PaymentReceipt) GetCoins() sdk.Coins {
+	var coins sdk.Coins
+	for _, coin := range receipt.Amount {
+		coins = append(coins, *coin)
+	}
+
+	return coins
+}
+
+// Validation
+
+func (receipt PaymentReceipt) Validate() error {
+	return validation.ValidateStruct(&receipt,
+		validation.Field(&receipt.RequestId, validation.Required, IsUUID()),
+		validation.Field(&receipt.Payer, validation.Required, IsBech32Address()),
+		validation.Field(&receipt.Payee, validation.Required, IsBech32Address()),
+		validation.Field(&receipt.Amount, validation.Required),
+		validation.Field(&receipt.Height, validation.Required),
+		validation.Field(&receipt.TxHash, validation.Required),
+	)
+}
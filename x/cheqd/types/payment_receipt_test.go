@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPaymentRequestId(t *testing.T) {
+	cases := []struct {
+		name      string
+		memo      string
+		requestId string
+		found     bool
+	}{
+		{
+			name:      "positive: request id",
+			memo:      "0a2bc4a6-1f45-4ff0-a046-703c71ab845d",
+			requestId: "0a2bc4a6-1f45-4ff0-a046-703c71ab845d",
+			found:     true,
+		},
+		{
+			name:      "positive: uppercase request id with spaces",
+			memo:      " 0A2BC4A6-1F45-4FF0-A046-703C71AB845D\n",
+			requestId: "0a2bc4a6-1f45-4ff0-a046-703c71ab845d",
+			found:     true,
+		},
+		{
+			name: "negative: empty memo",
+			memo: "",
+		},
+		{
+			name: "negative: request id followed by a comment",
+			memo: "0a2bc4a6-1f45-4ff0-a046-703c71ab845d thanks",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requestId, found := GetPaymentRequestId(tc.memo)
+
+			require.Equal(t, tc.found, found)
+			require.Equal(t, tc.requestId, requestId)
+		})
+	}
+}
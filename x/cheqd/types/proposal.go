@@ -0,0 +1,203 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	ProposalTypeSetNamespaces        = "SetNamespaces"
+	ProposalTypeDeactivateDid        = "DeactivateDid"
+	ProposalTypeAddTrustedIssuers    = "AddTrustedIssuers"
+	ProposalTypeRemoveTrustedIssuers = "RemoveTrustedIssuers"
+	ProposalTypeSetReservedIds       = "SetReservedIds"
+	ProposalTypeReleaseReservedIds   = "ReleaseReservedIds"
+)
+
+var (
+	_ govtypes.Content = &SetNamespacesProposal{}
+	_ govtypes.Content = &DeactivateDidProposal{}
+	_ govtypes.Content = &AddTrustedIssuersProposal{}
+	_ govtypes.Content = &RemoveTrustedIssuersProposal{}
+	_ govtypes.Content = &SetReservedIdsProposal{}
+	_ govtypes.Content = &ReleaseReservedIdsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetNamespaces)
+	govtypes.RegisterProposalType(ProposalTypeDeactivateDid)
+	govtypes.RegisterProposalType(ProposalTypeAddTrustedIssuers)
+	govtypes.RegisterProposalType(ProposalTypeRemoveTrustedIssuers)
+	govtypes.RegisterProposalType(ProposalTypeSetReservedIds)
+	govtypes.RegisterProposalType(ProposalTypeReleaseReservedIds)
+}
+
+func NewSetNamespacesProposal(title, description string, namespaces []*Namespace) *SetNamespacesProposal {
+	return &SetNamespacesProposal{
+		Title:       title,
+		Description: description,
+		Namespaces:  namespaces,
+	}
+}
+
+func (p *SetNamespacesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetNamespacesProposal) ProposalType() string { return ProposalTypeSetNamespaces }
+
+func (p *SetNamespacesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.Namespaces, validation.Required, IsUniqueNamespaceListRule(), validation.Each(ValidNamespaceRule())),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func NewDeactivateDidProposal(title, description, did string, redactedFields []string) *DeactivateDidProposal {
+	return &DeactivateDidProposal{
+		Title:          title,
+		Description:    description,
+		Did:            did,
+		RedactedFields: redactedFields,
+	}
+}
+
+func (p *DeactivateDidProposal) ProposalRoute() string { return RouterKey }
+
+func (p *DeactivateDidProposal) ProposalType() string { return ProposalTypeDeactivateDid }
+
+func (p *DeactivateDidProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.Did, validation.Required, IsDID(nil)),
+		validation.Field(&p.RedactedFields, IsUniqueStrList(), validation.Each(validation.In(DidFieldService, DidFieldAlsoKnownAs))),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func NewAddTrustedIssuersProposal(title, description string, trustedIssuers []*TrustedIssuer) *AddTrustedIssuersProposal {
+	return &AddTrustedIssuersProposal{
+		Title:          title,
+		Description:    description,
+		TrustedIssuers: trustedIssuers,
+	}
+}
+
+func (p *AddTrustedIssuersProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddTrustedIssuersProposal) ProposalType() string { return ProposalTypeAddTrustedIssuers }
+
+func (p *AddTrustedIssuersProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.TrustedIssuers, validation.Required, IsUniqueTrustedIssuerListRule(), validation.Each(ValidTrustedIssuerRule())),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func NewRemoveTrustedIssuersProposal(title, description string, trustedIssuers []*TrustedIssuerRef) *RemoveTrustedIssuersProposal {
+	return &RemoveTrustedIssuersProposal{
+		Title:          title,
+		Description:    description,
+		TrustedIssuers: trustedIssuers,
+	}
+}
+
+func (p *RemoveTrustedIssuersProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveTrustedIssuersProposal) ProposalType() string { return ProposalTypeRemoveTrustedIssuers }
+
+func (p *RemoveTrustedIssuersProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.TrustedIssuers, validation.Required, IsUniqueTrustedIssuerRefListRule(), validation.Each(ValidTrustedIssuerRefRule())),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func NewSetReservedIdsProposal(title, description string, reservedIds []*ReservedId) *SetReservedIdsProposal {
+	return &SetReservedIdsProposal{
+		Title:       title,
+		Description: description,
+		ReservedIds: reservedIds,
+	}
+}
+
+func (p *SetReservedIdsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetReservedIdsProposal) ProposalType() string { return ProposalTypeSetReservedIds }
+
+func (p *SetReservedIdsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.ReservedIds, validation.Required, IsUniqueReservedIdListRule(), validation.Each(ValidReservedIdRule())),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func NewReleaseReservedIdsProposal(title, description string, dids []string) *ReleaseReservedIdsProposal {
+	return &ReleaseReservedIdsProposal{
+		Title:       title,
+		Description: description,
+		Dids:        dids,
+	}
+}
+
+func (p *ReleaseReservedIdsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReleaseReservedIdsProposal) ProposalType() string { return ProposalTypeReleaseReservedIds }
+
+func (p *ReleaseReservedIdsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	err = validation.ValidateStruct(p,
+		validation.Field(&p.Dids, validation.Required, IsUniqueStrList(), validation.Each(IsDID(nil))),
+	)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateDidProposalValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		proposal *DeactivateDidProposal
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:     "positive: no redaction",
+			proposal: NewDeactivateDidProposal("title", "description", "did:cheqd:test:aaaaaaaaaaaaaaaa", nil),
+			isValid:  true,
+		},
+		{
+			name:     "positive: redacted fields",
+			proposal: NewDeactivateDidProposal("title", "description", "did:cheqd:test:aaaaaaaaaaaaaaaa", []string{DidFieldService, DidFieldAlsoKnownAs}),
+			isValid:  true,
+		},
+		{
+			name:     "negative: invalid did",
+			proposal: NewDeactivateDidProposal("title", "description", "did:cheqd:test", nil),
+			isValid:  false,
+			errorMsg: "did: unique id length should be 16 or 32 symbols.: basic validation failed",
+		},
+		{
+			name:     "negative: field can't be redacted",
+			proposal: NewDeactivateDidProposal("title", "description", "did:cheqd:test:aaaaaaaaaaaaaaaa", []string{"verificationMethod"}),
+			isValid:  false,
+			errorMsg: "redacted_fields: (0: must be a valid value.).: basic validation failed",
+		},
+		{
+			name:     "negative: duplicated fields",
+			proposal: NewDeactivateDidProposal("title", "description", "did:cheqd:test:aaaaaaaaaaaaaaaa", []string{DidFieldService, DidFieldService}),
+			isValid:  false,
+			errorMsg: "redacted_fields: there should be no duplicates.: basic validation failed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
@@ -1,5 +1,32 @@
 package types
 
 const (
-	QueryGetDid = "get-did"
+	QueryGetDid         = "get-did"
+	QueryGetDidDiff     = "did-diff"
+	QueryGetParams      = "params"
+	QueryGetFeeSchedule = "fees"
+	QueryGetNamespaces  = "namespaces"
+
+	QueryGetTrustedIssuersByIssuer         = "trusted-issuers-by-issuer"
+	QueryGetTrustedIssuersByCredentialType = "trusted-issuers-by-credential-type"
+
+	QueryGetIdStatus = "id-status"
+
+	QueryGetSchema = "get-schema"
+
+	QueryGetCredDef          = "get-cred-def"
+	QueryGetCredDefsByIssuer = "cred-defs-by-issuer"
+
+	QueryGetRevocRegDef         = "get-revoc-reg-def"
+	QueryGetRevocRegEntry       = "get-revoc-reg-entry"
+	QueryGetRevocRegEntryAtTime = "get-revoc-reg-entry-at-time"
+
+	QueryGetStatusList      = "get-status-list"
+	QueryGetStatusListIndex = "get-status-list-index"
+
+	QueryGetResource            = "get-resource"
+	QueryGetCollectionResources = "collection-resources"
+
+	QueryGetEscrow         = "get-escrow"
+	QueryGetPaymentReceipt = "get-payment-receipt"
 )
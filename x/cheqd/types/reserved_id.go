@@ -0,0 +1,104 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/multiformats/go-multibase"
+)
+
+func NewReservedId(did string, allowedCreatorKey string) ReservedId {
+	return ReservedId{
+		Did:               did,
+		Status:            ReservationStatus_RESERVATION_STATUS_RESERVED,
+		AllowedCreatorKey: allowedCreatorKey,
+	}
+}
+
+func NewBlockedId(did string) ReservedId {
+	return ReservedId{
+		Did:    did,
+		Status: ReservationStatus_RESERVATION_STATUS_BLOCKED,
+	}
+}
+
+func (r ReservedId) IsBlocked() bool {
+	return r.Status == ReservationStatus_RESERVATION_STATUS_BLOCKED
+}
+
+// IsAllowedCreator checks whether the verification method holds the allowed creator key of the reserved DID
+func (r ReservedId) IsAllowedCreator(vm VerificationMethod) bool {
+	if r.IsBlocked() {
+		return false
+	}
+
+	_, allowedKey, err := multibase.Decode(r.AllowedCreatorKey)
+	if err != nil {
+		return false
+	}
+
+	pubKey, err := vm.GetPublicKey()
+	if err != nil {
+		return false
+	}
+
+	ed25519PubKey, ok := pubKey.(ed25519.PublicKey)
+	return ok && bytes.Equal(ed25519PubKey, allowedKey)
+}
+
+// Helpers
+
+func GetReservedIdDids(reservedIds []*ReservedId) []string {
+	res := make([]string, len(reservedIds))
+
+	for i := range reservedIds {
+		res[i] = reservedIds[i].Did
+	}
+
+	return res
+}
+
+// Validation
+
+func (r ReservedId) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Did, validation.Required, IsDID(nil)),
+		validation.Field(&r.Status, validation.Required, validation.In(
+			ReservationStatus_RESERVATION_STATUS_RESERVED,
+			ReservationStatus_RESERVATION_STATUS_BLOCKED,
+		)),
+		validation.Field(&r.AllowedCreatorKey,
+			validation.When(r.IsBlocked(), validation.Empty),
+			validation.When(!r.IsBlocked(), validation.Required, IsMultibaseEncodedEd25519PubKey()),
+		),
+	)
+}
+
+func ValidReservedIdRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(ReservedId)
+		if !ok {
+			panic("ValidReservedIdRule must be only applied on reserved ids")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func IsUniqueReservedIdListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*ReservedId)
+		if !ok {
+			panic("IsUniqueReservedIdListRule must be only applied on reserved id lists")
+		}
+
+		if !utils.IsUnique(GetReservedIdDids(casted)) {
+			return errors.New("there should be no duplicates")
+		}
+
+		return nil
+	})
+}
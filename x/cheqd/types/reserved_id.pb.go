@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/reserved_id.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReservationStatus defines whether a DID can be created by anyone, by the allowed creator only or by no one
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	// The DID can be created only with a signature of the allowed creator key
+	ReservationStatus_RESERVATION_STATUS_RESERVED ReservationStatus = 1
+	// The DID can't be created
+	ReservationStatus_RESERVATION_STATUS_BLOCKED ReservationStatus = 2
+)
+
+var ReservationStatus_name = map[int32]string{
+	0: "RESERVATION_STATUS_UNSPECIFIED",
+	1: "RESERVATION_STATUS_RESERVED",
+	2: "RESERVATION_STATUS_BLOCKED",
+}
+
+var ReservationStatus_value = map[string]int32{
+	"RESERVATION_STATUS_UNSPECIFIED": 0,
+	"RESERVATION_STATUS_RESERVED":    1,
+	"RESERVATION_STATUS_BLOCKED":     2,
+}
+
+func (x ReservationStatus) String() string {
+	return proto.EnumName(ReservationStatus_name, int32(x))
+}
+
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82333a1bbfa8261a, []int{0}
+}
+
+// IdStatus defines the status of a DID for a creator
+type IdStatus int32
+
+const (
+	IdStatus_ID_STATUS_UNSPECIFIED IdStatus = 0
+	IdStatus_ID_STATUS_AVAILABLE   IdStatus = 1
+	IdStatus_ID_STATUS_IN_USE      IdStatus = 2
+	IdStatus_ID_STATUS_RESERVED    IdStatus = 3
+	IdStatus_ID_STATUS_BLOCKED     IdStatus = 4
+)
+
+var IdStatus_name = map[int32]string{
+	0: "ID_STATUS_UNSPECIFIED",
+	1: "ID_STATUS_AVAILABLE",
+	2: "ID_STATUS_IN_USE",
+	3: "ID_STATUS_RESERVED",
+	4: "ID_STATUS_BLOCKED",
+}
+
+var IdStatus_value = map[string]int32{
+	"ID_STATUS_UNSPECIFIED": 0,
+	"ID_STATUS_AVAILABLE":   1,
+	"ID_STATUS_IN_USE":      2,
+	"ID_STATUS_RESERVED":    3,
+	"ID_STATUS_BLOCKED":     4,
+}
+
+func (x IdStatus) String() string {
+	return proto.EnumName(IdStatus_name, int32(x))
+}
+
+func (IdStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82333a1bbfa8261a, []int{1}
+}
+
+// ReservedId is an entry of the list of reserved and blocked DIDs.
+type ReservedId struct {
+	Did    string            `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Status ReservationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cheqdid.cheqdnode.cheqd.v1.ReservationStatus" json:"status,omitempty"`
+	// Multibase-encoded Ed25519 public key allowed to create a reserved DID. Empty for blocked DIDs.
+	AllowedCreatorKey string `protobuf:"bytes,3,opt,name=allowed_creator_key,json=allowedCreatorKey,proto3" json:"allowed_creator_key,omitempty"`
+}
+
+func (m *ReservedId) Reset()         { *m = ReservedId{} }
+func (m *ReservedId) String() string { return proto.CompactTextString(m) }
+func (*ReservedId) ProtoMessage()    {}
+func (*ReservedId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82333a1bbfa8261a, []int{0}
+}
+func (m *ReservedId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReservedId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReservedId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReservedId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReservedId.Merge(m, src)
+}
+func (m *ReservedId) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReservedId) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReservedId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReservedId proto.InternalMessageInfo
+
+func (m *ReservedId) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *ReservedId) GetStatus() ReservationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (m *ReservedId) GetAllowedCreatorKey() string {
+	if m != nil {
+		return m.AllowedCreatorKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cheqdid.cheqdnode.cheqd.v1.ReservationStatus", ReservationStatus_name, ReservationStatus_value)
+	proto.RegisterEnum("cheqdid.cheqdnode.cheqd.v1.IdStatus", IdStatus_name, IdStatus_value)
+	proto.RegisterType((*ReservedId)(nil), "cheqdid.cheqdnode.cheqd.v1.ReservedId")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/reserved_id.proto", fileDescriptor_82333a1bbfa8261a) }
+
+var fileDescriptor_82333a1bbfa8261a = []byte{
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xc1, 0x4e, 0xc2, 0x40,
+	0x14, 0xec, 0x82, 0x21, 0xfa, 0x0e, 0xa6, 0x2c, 0xa2, 0x88, 0xc9, 0x4a, 0x38, 0x21, 0x09, 0x6d,
+	0xd0, 0x2f, 0x28, 0xed, 0x9a, 0x6c, 0x20, 0x60, 0x5a, 0xe0, 0xe0, 0xa5, 0x29, 0xec, 0x46, 0x1a,
+	0x91, 0x62, 0x5b, 0x10, 0xce, 0xfe, 0x80, 0x17, 0xff, 0xc9, 0x23, 0x47, 0x8f, 0x06, 0x7e, 0xc4,
+	0xd0, 0x36, 0x92, 0x28, 0x5e, 0x76, 0x5f, 0x66, 0xde, 0xcc, 0xbc, 0x64, 0xa0, 0x38, 0x1c, 0x89,
+	0x67, 0xae, 0xce, 0xeb, 0xaa, 0x2f, 0x02, 0xe1, 0xcf, 0x05, 0xb7, 0x5d, 0xae, 0x4c, 0x7d, 0x2f,
+	0xf4, 0x70, 0xcc, 0xb9, 0x5c, 0x89, 0xfe, 0x89, 0xc7, 0x45, 0x3c, 0x29, 0xf3, 0x7a, 0xf9, 0x1d,
+	0x01, 0x98, 0x89, 0x82, 0x71, 0x2c, 0x43, 0x9a, 0xbb, 0xbc, 0x80, 0x4a, 0xa8, 0x72, 0x64, 0x6e,
+	0x47, 0x4c, 0x21, 0x13, 0x84, 0x4e, 0x38, 0x0b, 0x0a, 0xa9, 0x12, 0xaa, 0x1c, 0x5f, 0xd7, 0x94,
+	0xff, 0xdd, 0x94, 0xd8, 0xc9, 0x09, 0x5d, 0x6f, 0x62, 0x45, 0x22, 0x33, 0x11, 0x63, 0x05, 0x72,
+	0xce, 0x78, 0xec, 0xbd, 0x08, 0x6e, 0x0f, 0x7d, 0xe1, 0x84, 0x9e, 0x6f, 0x3f, 0x8a, 0x65, 0x21,
+	0x1d, 0x05, 0x65, 0x13, 0x4a, 0x8f, 0x99, 0xa6, 0x58, 0x56, 0x17, 0x90, 0xfd, 0x63, 0x86, 0xcb,
+	0x40, 0x4c, 0x6a, 0x51, 0xb3, 0xaf, 0x75, 0x59, 0xa7, 0x6d, 0x5b, 0x5d, 0xad, 0xdb, 0xb3, 0xec,
+	0x5e, 0xdb, 0xba, 0xa3, 0x3a, 0xbb, 0x65, 0xd4, 0x90, 0x25, 0x7c, 0x09, 0x17, 0x7b, 0x76, 0x62,
+	0x88, 0x1a, 0x32, 0xc2, 0x04, 0x8a, 0x7b, 0x16, 0x1a, 0xad, 0x8e, 0xde, 0xa4, 0x86, 0x9c, 0xaa,
+	0xbe, 0x22, 0x38, 0x64, 0x3c, 0x49, 0x3c, 0x87, 0x3c, 0x33, 0xf6, 0x07, 0x9d, 0x41, 0x6e, 0x47,
+	0x69, 0x7d, 0x8d, 0xb5, 0xb4, 0x46, 0x8b, 0xca, 0x08, 0x9f, 0x80, 0xbc, 0x23, 0x58, 0xdb, 0xee,
+	0x59, 0x54, 0x4e, 0xe1, 0x53, 0xc0, 0x3b, 0xf4, 0xe7, 0x9c, 0x34, 0xce, 0x43, 0x96, 0x19, 0xbf,
+	0xaf, 0x38, 0x68, 0xe8, 0x1f, 0x6b, 0x82, 0x56, 0x6b, 0x82, 0xbe, 0xd6, 0x04, 0xbd, 0x6d, 0x88,
+	0xb4, 0xda, 0x10, 0xe9, 0x73, 0x43, 0xa4, 0xfb, 0xab, 0x07, 0x37, 0x1c, 0xcd, 0x06, 0xca, 0xd0,
+	0x7b, 0x52, 0xe3, 0xd2, 0xa3, 0xb7, 0xb6, 0x6d, 0x42, 0x5d, 0x24, 0x50, 0xb8, 0x9c, 0x8a, 0x60,
+	0x90, 0x89, 0xfa, 0xbf, 0xf9, 0x1e, 0x00, 0x8f, 0xea, 0x14, 0xae, 0x1d, 0x02, 0x00, 0x00,
+}
+
+func (m *ReservedId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReservedId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReservedId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedCreatorKey) > 0 {
+		i -= len(m.AllowedCreatorKey)
+		copy(dAtA[i:], m.AllowedCreatorKey)
+		i = encodeVarintReservedId(dAtA, i, uint64(len(m.AllowedCreatorKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintReservedId(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintReservedId(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReservedId(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReservedId(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReservedId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovReservedId(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovReservedId(uint64(m.Status))
+	}
+	l = len(m.AllowedCreatorKey)
+	if l > 0 {
+		n += 1 + l + sovReservedId(uint64(l))
+	}
+	return n
+}
+
+func sovReservedId(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReservedId(x uint64) (n int) {
+	return sovReservedId(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReservedId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReservedId
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReservedId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReservedId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservedId
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservedId
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservedId
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservedId
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReservationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCreatorKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReservedId
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReservedId
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReservedId
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCreatorKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReservedId(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReservedId
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReservedId(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReservedId
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservedId
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReservedId
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReservedId
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReservedId
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReservedId
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReservedId        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReservedId          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReservedId = fmt.Errorf("proto: unexpected end of group")
+)
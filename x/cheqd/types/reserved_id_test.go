@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservedIdValidation(t *testing.T) {
+	cases := []struct {
+		name       string
+		reservedId ReservedId
+		isValid    bool
+		errorMsg   string
+	}{
+		{
+			name:       "positive: reserved id",
+			reservedId: NewReservedId("did:cheqd:test:aaaaaaaaaaaaaaaa", ValidEd25519PubKey),
+			isValid:    true,
+		},
+		{
+			name:       "positive: blocked id",
+			reservedId: NewBlockedId("did:cheqd:test:aaaaaaaaaaaaaaaa"),
+			isValid:    true,
+		},
+		{
+			name:       "negative: reserved id without creator key",
+			reservedId: NewReservedId("did:cheqd:test:aaaaaaaaaaaaaaaa", ""),
+			isValid:    false,
+			errorMsg:   "allowed_creator_key: cannot be blank.",
+		},
+		{
+			name:       "negative: blocked id with creator key",
+			reservedId: ReservedId{Did: "did:cheqd:test:aaaaaaaaaaaaaaaa", Status: ReservationStatus_RESERVATION_STATUS_BLOCKED, AllowedCreatorKey: ValidEd25519PubKey},
+			isValid:    false,
+			errorMsg:   "allowed_creator_key: must be blank.",
+		},
+		{
+			name:       "negative: unspecified status",
+			reservedId: ReservedId{Did: "did:cheqd:test:aaaaaaaaaaaaaaaa", AllowedCreatorKey: ValidEd25519PubKey},
+			isValid:    false,
+			errorMsg:   "status: cannot be blank.",
+		},
+		{
+			name:       "negative: invalid did",
+			reservedId: NewBlockedId("did:cheqd:test:aaaa"),
+			isValid:    false,
+			errorMsg:   "did: unique id length should be 16 or 32 symbols.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.reservedId.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
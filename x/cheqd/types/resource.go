@@ -0,0 +1,69 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ StateValueData = &Resource{}
+
+// MediaTypeRegexp matches IANA media types without parameters, e.g. application/ld+json
+var MediaTypeRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+-]*/[a-zA-Z0-9][a-zA-Z0-9!#$&^_.+-]*$`)
+
+func NewResource(collectionId string, id string, name string, resourceType string, mediaType string, data []byte) Resource {
+	checksum := sha256.Sum256(data)
+
+	return Resource{
+		Header: &ResourceHeader{
+			CollectionId: collectionId,
+			Id:           id,
+			Name:         name,
+			ResourceType: resourceType,
+			MediaType:    mediaType,
+			Checksum:     hex.EncodeToString(checksum[:]),
+		},
+		Data: data,
+	}
+}
+
+// IsNextVersionOf checks whether the resource is a new version of the other one.
+// Versions of a resource share the collection, name and resource type.
+func (header ResourceHeader) IsNextVersionOf(other ResourceHeader) bool {
+	return header.CollectionId == other.CollectionId && header.Name == other.Name && header.ResourceType == other.ResourceType
+}
+
+// Validation
+
+func (resource Resource) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&resource,
+		validation.Field(&resource.Header, validation.Required, ValidResourceHeaderRule(allowedNamespaces)),
+		validation.Field(&resource.Data, validation.Required),
+	)
+}
+
+func (header ResourceHeader) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&header,
+		validation.Field(&header.CollectionId, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&header.Id, validation.Required, IsUUID()),
+		validation.Field(&header.Name, validation.Required),
+		validation.Field(&header.ResourceType, validation.Required),
+		validation.Field(&header.MediaType, validation.Required, validation.Match(MediaTypeRegexp)),
+		validation.Field(&header.Checksum, validation.Required),
+		validation.Field(&header.PreviousVersionId, validation.When(header.PreviousVersionId != "", IsUUID())),
+		validation.Field(&header.NextVersionId, validation.When(header.NextVersionId != "", IsUUID())),
+	)
+}
+
+func ValidResourceHeaderRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*ResourceHeader)
+		if !ok {
+			panic("ValidResourceHeaderRule must be only applied on ResourceHeader properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceValidation(t *testing.T) {
+	const (
+		collectionId = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+		resourceId   = "9fbb1b86-91f8-4942-97b9-725b7714131c"
+	)
+
+	withVersion := NewResource(collectionId, resourceId, "Logo", "Logo", "image/png", []byte{0x89})
+	withVersion.Header.PreviousVersionId = "previous"
+
+	cases := []struct {
+		name     string
+		resource Resource
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:     "positive: JSON-LD context",
+			resource: NewResource(collectionId, resourceId, "Context", "JSONLDContext", "application/ld+json", []byte(`{"@context": {}}`)),
+			isValid:  true,
+		},
+		{
+			name:     "negative: id is not a UUID",
+			resource: NewResource(collectionId, "logo", "Logo", "Logo", "image/png", []byte{0x89}),
+			isValid:  false,
+			errorMsg: "header: (id: must be a UUID.).",
+		},
+		{
+			name:     "negative: uppercase UUID",
+			resource: NewResource(collectionId, "9FBB1B86-91F8-4942-97B9-725B7714131C", "Logo", "Logo", "image/png", []byte{0x89}),
+			isValid:  false,
+			errorMsg: "header: (id: UUID must be lowercase: 9fbb1b86-91f8-4942-97b9-725b7714131c.).",
+		},
+		{
+			name:     "negative: invalid media type",
+			resource: NewResource(collectionId, resourceId, "Logo", "Logo", "png", []byte{0x89}),
+			isValid:  false,
+			errorMsg: "header: (media_type: must be in a valid format.).",
+		},
+		{
+			name:     "negative: no name and content",
+			resource: NewResource(collectionId, resourceId, "", "Logo", "image/png", nil),
+			isValid:  false,
+			errorMsg: "data: cannot be blank; header: (name: cannot be blank.).",
+		},
+		{
+			name:     "negative: invalid version link",
+			resource: withVersion,
+			isValid:  false,
+			errorMsg: "header: (previous_version_id: must be a UUID.).",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.resource.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestResourceChecksum(t *testing.T) {
+	resource := NewResource("did:cheqd:test:aaaaaaaaaaaaaaaa", "9fbb1b86-91f8-4942-97b9-725b7714131c", "Logo", "Logo", "text/plain", []byte("abc"))
+	require.Equal(t, "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", resource.Header.Checksum)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var (
+	_ StateValueData = &RevocRegDef{}
+	_ StateValueData = &RevocRegEntry{}
+)
+
+const RevocRegTypeCLAccum = "CL_ACCUM"
+
+func NewRevocRegDef(id string, revocRegType string, credDefId string, tag string, value string, controller []string) RevocRegDef {
+	return RevocRegDef{
+		Id:         id,
+		Type:       revocRegType,
+		CredDefId:  credDefId,
+		Tag:        tag,
+		Value:      value,
+		Controller: controller,
+	}
+}
+
+func NewRevocRegEntry(revocRegDefId string, prevAccum string, accum string, issued []uint64, revoked []uint64) RevocRegEntry {
+	return RevocRegEntry{
+		RevocRegDefId: revocRegDefId,
+		PrevAccum:     prevAccum,
+		Accum:         accum,
+		Issued:        issued,
+		Revoked:       revoked,
+	}
+}
+
+// Validation
+
+func (def RevocRegDef) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&def,
+		validation.Field(&def.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&def.Type, validation.Required, validation.In(RevocRegTypeCLAccum)),
+		validation.Field(&def.CredDefId, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&def.Tag, validation.Required),
+		validation.Field(&def.Value, validation.Required, ValidRevocRegDefValueRule()),
+		validation.Field(&def.Controller, validation.Required, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
+	)
+}
+
+func ValidRevocRegDefValueRule() *CustomErrorRule {
+	return IsJSONObject("maxCredNum", "tailsHash", "tailsLocation")
+}
+
+// Validate checks the entry submitted by a client, so the fields set by the ledger are not checked
+func (entry RevocRegEntry) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&entry,
+		validation.Field(&entry.RevocRegDefId, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&entry.Accum, validation.Required),
+		validation.Field(&entry.Issued, IsUniqueCredIndexListRule()),
+		validation.Field(&entry.Revoked, IsUniqueCredIndexListRule(), IsDisjointCredIndexListRule(entry.Issued)),
+	)
+}
+
+// IsUniqueCredIndexListRule checks that the list of credential indices has no duplicates and zeros. Indices start from 1.
+func IsUniqueCredIndexListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]uint64)
+		if !ok {
+			panic("IsUniqueCredIndexListRule must be only applied on uint64 array properties")
+		}
+
+		seen := make(map[uint64]bool, len(casted))
+		for _, index := range casted {
+			if index == 0 {
+				return errors.New("credential indices start from 1")
+			}
+
+			if seen[index] {
+				return errors.New("there should be no duplicates")
+			}
+
+			seen[index] = true
+		}
+
+		return nil
+	})
+}
+
+// IsDisjointCredIndexListRule checks that none of the credential indices is in the other list
+func IsDisjointCredIndexListRule(other []uint64) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]uint64)
+		if !ok {
+			panic("IsDisjointCredIndexListRule must be only applied on uint64 array properties")
+		}
+
+		for _, index := range casted {
+			for _, otherIndex := range other {
+				if index == otherIndex {
+					return fmt.Errorf("credential index %d can't be both issued and revoked", index)
+				}
+			}
+		}
+
+		return nil
+	})
+}
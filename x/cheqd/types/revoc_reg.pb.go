@@ -0,0 +1,1163 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cheqd/v1/revoc_reg.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RevocRegDef is a revocation registry definition defined by ADR-007.
+// It's stored in a StateValue under the "revoc-reg-def:" prefix.
+type RevocRegDef struct {
+	// id is a DID-like identifier of the revocation registry: did:cheqd:<namespace>:<unique-id>
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the accumulator type, only "CL_ACCUM" is supported
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// cred_def_id is the id of the credential definition the registry is created for
+	CredDefId string `protobuf:"bytes,3,opt,name=cred_def_id,json=credDefId,proto3" json:"cred_def_id,omitempty"`
+	Tag       string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// value is the JSON encoded registry configuration:
+	// {"issuanceType": ..., "maxCredNum": ..., "publicKeys": {...}, "tailsHash": ..., "tailsLocation": ...}
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// controller is the list of issuer DIDs that control the registry, all of them must control the credential definition
+	Controller []string `protobuf:"bytes,6,rep,name=controller,proto3" json:"controller,omitempty"`
+}
+
+func (m *RevocRegDef) Reset()         { *m = RevocRegDef{} }
+func (m *RevocRegDef) String() string { return proto.CompactTextString(m) }
+func (*RevocRegDef) ProtoMessage()    {}
+func (*RevocRegDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bf8567dd2ba382a, []int{0}
+}
+func (m *RevocRegDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegDef.Merge(m, src)
+}
+func (m *RevocRegDef) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegDef proto.InternalMessageInfo
+
+func (m *RevocRegDef) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetCredDefId() string {
+	if m != nil {
+		return m.CredDefId
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *RevocRegDef) GetController() []string {
+	if m != nil {
+		return m.Controller
+	}
+	return nil
+}
+
+// RevocRegEntry is a delta of a revocation registry defined by ADR-007.
+// The latest entry is stored in a StateValue under the "revoc-reg-entry:" prefix,
+// all the entries are kept under the "revoc-reg-entry-history:" prefix ordered by time.
+type RevocRegEntry struct {
+	RevocRegDefId string `protobuf:"bytes,1,opt,name=revoc_reg_def_id,json=revocRegDefId,proto3" json:"revoc_reg_def_id,omitempty"`
+	// prev_accum is the accumulator value the delta is applied to, empty for the first entry
+	PrevAccum string `protobuf:"bytes,2,opt,name=prev_accum,json=prevAccum,proto3" json:"prev_accum,omitempty"`
+	// accum is the accumulator value after the delta is applied
+	Accum string `protobuf:"bytes,3,opt,name=accum,proto3" json:"accum,omitempty"`
+	// issued is the list of credential indices issued by the delta
+	Issued []uint64 `protobuf:"varint,4,rep,packed,name=issued,proto3" json:"issued,omitempty"`
+	// revoked is the list of credential indices revoked by the delta
+	Revoked []uint64 `protobuf:"varint,5,rep,packed,name=revoked,proto3" json:"revoked,omitempty"`
+	// timestamp is the block time the entry was written at, as a unix timestamp in seconds. Set by the ledger.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// seq_no is the sequence number of the entry within the registry starting from 1. Set by the ledger.
+	SeqNo uint64 `protobuf:"varint,7,opt,name=seq_no,json=seqNo,proto3" json:"seq_no,omitempty"`
+}
+
+func (m *RevocRegEntry) Reset()         { *m = RevocRegEntry{} }
+func (m *RevocRegEntry) String() string { return proto.CompactTextString(m) }
+func (*RevocRegEntry) ProtoMessage()    {}
+func (*RevocRegEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bf8567dd2ba382a, []int{1}
+}
+func (m *RevocRegEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocRegEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocRegEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocRegEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocRegEntry.Merge(m, src)
+}
+func (m *RevocRegEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocRegEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocRegEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocRegEntry proto.InternalMessageInfo
+
+func (m *RevocRegEntry) GetRevocRegDefId() string {
+	if m != nil {
+		return m.RevocRegDefId
+	}
+	return ""
+}
+
+func (m *RevocRegEntry) GetPrevAccum() string {
+	if m != nil {
+		return m.PrevAccum
+	}
+	return ""
+}
+
+func (m *RevocRegEntry) GetAccum() string {
+	if m != nil {
+		return m.Accum
+	}
+	return ""
+}
+
+func (m *RevocRegEntry) GetIssued() []uint64 {
+	if m != nil {
+		return m.Issued
+	}
+	return nil
+}
+
+func (m *RevocRegEntry) GetRevoked() []uint64 {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *RevocRegEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RevocRegEntry) GetSeqNo() uint64 {
+	if m != nil {
+		return m.SeqNo
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RevocRegDef)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegDef")
+	proto.RegisterType((*RevocRegEntry)(nil), "cheqdid.cheqdnode.cheqd.v1.RevocRegEntry")
+}
+
+func init() { proto.RegisterFile("cheqd/v1/revoc_reg.proto", fileDescriptor_9bf8567dd2ba382a) }
+
+var fileDescriptor_9bf8567dd2ba382a = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0xce, 0xd3, 0x30,
+	0x14, 0xc5, 0xeb, 0xe6, 0xcf, 0xa7, 0xdc, 0x4f, 0x45, 0x95, 0x05, 0xc8, 0x42, 0x60, 0x45, 0x5d,
+	0x08, 0x03, 0x89, 0x2a, 0x9e, 0x00, 0x28, 0x43, 0x17, 0x86, 0x8c, 0x2c, 0x51, 0x1a, 0xdf, 0xa6,
+	0x11, 0x4d, 0x9c, 0x3a, 0x4e, 0x44, 0xdf, 0x82, 0x95, 0x37, 0x62, 0xec, 0xc0, 0xc0, 0x88, 0xda,
+	0x17, 0x41, 0x76, 0x5a, 0xca, 0x92, 0xdc, 0xf3, 0x3b, 0x96, 0x7d, 0xae, 0x0e, 0xb0, 0x62, 0x87,
+	0x07, 0x91, 0x0c, 0xcb, 0x44, 0xe1, 0x20, 0x8b, 0x4c, 0x61, 0x19, 0xb7, 0x4a, 0x6a, 0x49, 0x5f,
+	0x58, 0xa7, 0x12, 0xb1, 0xfd, 0x37, 0x52, 0xe0, 0x38, 0xc5, 0xc3, 0x72, 0xf1, 0x83, 0xc0, 0x63,
+	0x6a, 0xce, 0xa7, 0x58, 0xae, 0x70, 0x4b, 0x9f, 0xc0, 0xb4, 0x12, 0x8c, 0x84, 0x24, 0x0a, 0xd2,
+	0x69, 0x25, 0x28, 0x05, 0x57, 0x1f, 0x5b, 0x64, 0x53, 0x4b, 0xec, 0x4c, 0x39, 0x3c, 0x16, 0x0a,
+	0x45, 0x26, 0x70, 0x9b, 0x55, 0x82, 0x39, 0xd6, 0x0a, 0x0c, 0x5a, 0xe1, 0x76, 0x2d, 0xe8, 0x1c,
+	0x1c, 0x9d, 0x97, 0xcc, 0xb5, 0xdc, 0x8c, 0xf4, 0x29, 0x78, 0x43, 0xbe, 0xef, 0x91, 0x79, 0x96,
+	0x8d, 0x82, 0x72, 0x80, 0x42, 0x36, 0x5a, 0xc9, 0xfd, 0x1e, 0x15, 0xf3, 0x43, 0x27, 0x0a, 0xd2,
+	0xff, 0xc8, 0xe2, 0x17, 0x81, 0xd9, 0x2d, 0xdb, 0xa7, 0x46, 0xab, 0x23, 0x7d, 0x0d, 0xf3, 0x7f,
+	0xcb, 0xdd, 0x9e, 0x1f, 0xb3, 0xce, 0xd4, 0x7d, 0x89, 0xb5, 0xa0, 0xaf, 0x00, 0x5a, 0x85, 0x43,
+	0x96, 0x17, 0x45, 0x5f, 0x5f, 0xc3, 0x07, 0x86, 0xbc, 0x37, 0xc0, 0xe4, 0x19, 0x9d, 0x31, 0xfb,
+	0x28, 0xe8, 0x73, 0xf0, 0xab, 0xae, 0xeb, 0x51, 0x30, 0x37, 0x74, 0x22, 0x37, 0xbd, 0x2a, 0xca,
+	0xe0, 0xc1, 0xdc, 0xfe, 0x15, 0x05, 0xf3, 0xac, 0x71, 0x93, 0xf4, 0x25, 0x04, 0xba, 0xaa, 0xb1,
+	0xd3, 0x79, 0xdd, 0x32, 0x3f, 0x24, 0x91, 0x93, 0xde, 0x01, 0x7d, 0x06, 0x7e, 0x87, 0x87, 0xac,
+	0x91, 0xec, 0x21, 0x24, 0x91, 0x9b, 0x7a, 0x1d, 0x1e, 0x3e, 0xcb, 0x0f, 0x1f, 0x7f, 0x9e, 0x39,
+	0x39, 0x9d, 0x39, 0xf9, 0x73, 0xe6, 0xe4, 0xfb, 0x85, 0x4f, 0x4e, 0x17, 0x3e, 0xf9, 0x7d, 0xe1,
+	0x93, 0x2f, 0x6f, 0xca, 0x4a, 0xef, 0xfa, 0x4d, 0x5c, 0xc8, 0x3a, 0x19, 0xdb, 0xb4, 0xdf, 0xb7,
+	0xa6, 0xb2, 0xe4, 0xdb, 0x15, 0x99, 0x0a, 0xba, 0x8d, 0x6f, 0xab, 0x7d, 0xf7, 0x77, 0x00, 0x85,
+	0xb6, 0x95, 0xdb, 0xf6, 0x01, 0x00, 0x00,
+}
+
+func (m *RevocRegDef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Controller) > 0 {
+		for iNdEx := len(m.Controller) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Controller[iNdEx])
+			copy(dAtA[i:], m.Controller[iNdEx])
+			i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Controller[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CredDefId) > 0 {
+		i -= len(m.CredDefId)
+		copy(dAtA[i:], m.CredDefId)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.CredDefId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocRegEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocRegEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocRegEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SeqNo != 0 {
+		i = encodeVarintRevocReg(dAtA, i, uint64(m.SeqNo))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintRevocReg(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Revoked) > 0 {
+		dAtA2 := make([]byte, len(m.Revoked)*10)
+		var j1 int
+		for _, num := range m.Revoked {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRevocReg(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issued) > 0 {
+		dAtA4 := make([]byte, len(m.Issued)*10)
+		var j3 int
+		for _, num := range m.Issued {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintRevocReg(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Accum) > 0 {
+		i -= len(m.Accum)
+		copy(dAtA[i:], m.Accum)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.Accum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PrevAccum) > 0 {
+		i -= len(m.PrevAccum)
+		copy(dAtA[i:], m.PrevAccum)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.PrevAccum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RevocRegDefId) > 0 {
+		i -= len(m.RevocRegDefId)
+		copy(dAtA[i:], m.RevocRegDefId)
+		i = encodeVarintRevocReg(dAtA, i, uint64(len(m.RevocRegDefId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevocReg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevocReg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RevocRegDef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.CredDefId)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	if len(m.Controller) > 0 {
+		for _, s := range m.Controller {
+			l = len(s)
+			n += 1 + l + sovRevocReg(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RevocRegEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RevocRegDefId)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.PrevAccum)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	l = len(m.Accum)
+	if l > 0 {
+		n += 1 + l + sovRevocReg(uint64(l))
+	}
+	if len(m.Issued) > 0 {
+		l = 0
+		for _, e := range m.Issued {
+			l += sovRevocReg(uint64(e))
+		}
+		n += 1 + sovRevocReg(uint64(l)) + l
+	}
+	if len(m.Revoked) > 0 {
+		l = 0
+		for _, e := range m.Revoked {
+			l += sovRevocReg(uint64(e))
+		}
+		n += 1 + sovRevocReg(uint64(l)) + l
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRevocReg(uint64(m.Timestamp))
+	}
+	if m.SeqNo != 0 {
+		n += 1 + sovRevocReg(uint64(m.SeqNo))
+	}
+	return n
+}
+
+func sovRevocReg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevocReg(x uint64) (n int) {
+	return sovRevocReg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RevocRegDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocReg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = append(m.Controller, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocReg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocRegEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocReg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocRegEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocRegEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocRegDefId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocRegDefId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevAccum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevAccum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocReg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Issued = append(m.Issued, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocReg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocReg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocReg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Issued) == 0 {
+					m.Issued = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocReg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Issued = append(m.Issued, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issued", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocReg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Revoked = append(m.Revoked, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRevocReg
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRevocReg
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRevocReg
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Revoked) == 0 {
+					m.Revoked = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRevocReg
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Revoked = append(m.Revoked, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			m.SeqNo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeqNo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocReg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocReg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevocReg(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevocReg
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocReg
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevocReg
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevocReg
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevocReg
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevocReg        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevocReg          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevocReg = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocRegDefValidation(t *testing.T) {
+	const (
+		revocRegDefId = "did:cheqd:test:rrrrrrrrrrrrrrrr"
+		credDefId     = "did:cheqd:test:dddddddddddddddd"
+		controller    = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+		value         = `{"maxCredNum": 5, "tailsHash": "3MLj...RBJv", "tailsLocation": "https://tails.example.com/3MLj...RBJv"}`
+	)
+
+	cases := []struct {
+		name        string
+		revocRegDef RevocRegDef
+		isValid     bool
+		errorMsg    string
+	}{
+		{
+			name:        "positive: CL_ACCUM revocation registry",
+			revocRegDef: NewRevocRegDef(revocRegDefId, RevocRegTypeCLAccum, credDefId, "default", value, []string{controller}),
+			isValid:     true,
+		},
+		{
+			name:        "negative: unsupported type",
+			revocRegDef: NewRevocRegDef(revocRegDefId, "CL_ACCUM_V2", credDefId, "default", value, []string{controller}),
+			isValid:     false,
+			errorMsg:    "type: must be a valid value.",
+		},
+		{
+			name:        "negative: value without tails location",
+			revocRegDef: NewRevocRegDef(revocRegDefId, RevocRegTypeCLAccum, credDefId, "default", `{"maxCredNum": 5, "tailsHash": "3MLj...RBJv"}`, []string{controller}),
+			isValid:     false,
+			errorMsg:    "value: tailsLocation key is required.",
+		},
+		{
+			name:        "negative: invalid cred def id",
+			revocRegDef: NewRevocRegDef(revocRegDefId, RevocRegTypeCLAccum, "1:2:3", "default", value, []string{controller}),
+			isValid:     false,
+			errorMsg:    "cred_def_id: unable to split did into method, namespace and id.",
+		},
+		{
+			name:        "negative: duplicated controllers",
+			revocRegDef: NewRevocRegDef(revocRegDefId, RevocRegTypeCLAccum, credDefId, "default", value, []string{controller, controller}),
+			isValid:     false,
+			errorMsg:    "controller: there should be no duplicates.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.revocRegDef.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestRevocRegEntryValidation(t *testing.T) {
+	const revocRegDefId = "did:cheqd:test:rrrrrrrrrrrrrrrr"
+
+	cases := []struct {
+		name     string
+		entry    RevocRegEntry
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: issued and revoked indices",
+			entry:   NewRevocRegEntry(revocRegDefId, "accum-1", "accum-2", []uint64{1, 2}, []uint64{3}),
+			isValid: true,
+		},
+		{
+			name:    "positive: no changes",
+			entry:   NewRevocRegEntry(revocRegDefId, "", "accum-1", nil, nil),
+			isValid: true,
+		},
+		{
+			name:     "negative: no accumulator",
+			entry:    NewRevocRegEntry(revocRegDefId, "", "", nil, nil),
+			isValid:  false,
+			errorMsg: "accum: cannot be blank.",
+		},
+		{
+			name:     "negative: zero index",
+			entry:    NewRevocRegEntry(revocRegDefId, "", "accum-1", []uint64{0}, nil),
+			isValid:  false,
+			errorMsg: "issued: credential indices start from 1.",
+		},
+		{
+			name:     "negative: duplicated index",
+			entry:    NewRevocRegEntry(revocRegDefId, "", "accum-1", nil, []uint64{2, 2}),
+			isValid:  false,
+			errorMsg: "revoked: there should be no duplicates.",
+		},
+		{
+			name:     "negative: index is issued and revoked",
+			entry:    NewRevocRegEntry(revocRegDefId, "", "accum-1", []uint64{1, 2}, []uint64{2}),
+			isValid:  false,
+			errorMsg: "revoked: credential index 2 can't be both issued and revoked.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
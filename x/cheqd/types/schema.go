@@ -0,0 +1,38 @@
+package types
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ StateValueData = &Schema{}
+
+const (
+	SchemaTypeCL = "CL-Schema"
+
+	// MaxSchemaAttrNames is the maximum number of attributes of a CL schema
+	MaxSchemaAttrNames = 125
+)
+
+func NewSchema(id string, schemaType string, attrNames []string, name string, version string, controller []string) Schema {
+	return Schema{
+		Id:         id,
+		Type:       schemaType,
+		AttrNames:  attrNames,
+		Name:       name,
+		Version:    version,
+		Controller: controller,
+	}
+}
+
+// Validation
+
+func (schema Schema) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&schema,
+		validation.Field(&schema.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&schema.Type, validation.Required, validation.In(SchemaTypeCL)),
+		validation.Field(&schema.AttrNames, validation.Required, validation.Length(1, MaxSchemaAttrNames), IsUniqueStrList(), validation.Each(validation.Required)),
+		validation.Field(&schema.Name, validation.Required),
+		validation.Field(&schema.Version, validation.Required),
+		validation.Field(&schema.Controller, validation.Required, IsUniqueStrList(), validation.Each(IsDID(allowedNamespaces))),
+	)
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaValidation(t *testing.T) {
+	const (
+		schemaId   = "did:cheqd:test:ssssssssssssssss"
+		controller = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+	)
+
+	cases := []struct {
+		name              string
+		schema            Schema
+		allowedNamespaces []string
+		isValid           bool
+		errorMsg          string
+	}{
+		{
+			name:    "positive: CL schema",
+			schema:  NewSchema(schemaId, SchemaTypeCL, []string{"name", "age"}, "Passport", "1.0", []string{controller}),
+			isValid: true,
+		},
+		{
+			name:              "positive: allowed namespace",
+			schema:            NewSchema(schemaId, SchemaTypeCL, []string{"name"}, "Passport", "1.0", []string{controller}),
+			allowedNamespaces: []string{"test"},
+			isValid:           true,
+		},
+		{
+			name:              "negative: namespace is not allowed",
+			schema:            NewSchema(schemaId, SchemaTypeCL, []string{"name"}, "Passport", "1.0", []string{controller}),
+			allowedNamespaces: []string{"mainnet"},
+			isValid:           false,
+			errorMsg:          "controller: (0: did namespace must be one of: mainnet.); id: did namespace must be one of: mainnet.",
+		},
+		{
+			name:     "negative: unsupported type",
+			schema:   NewSchema(schemaId, "JSON-Schema", []string{"name"}, "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "type: must be a valid value.",
+		},
+		{
+			name:     "negative: no attributes",
+			schema:   NewSchema(schemaId, SchemaTypeCL, nil, "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "attr_names: cannot be blank.",
+		},
+		{
+			name:     "negative: duplicated attributes",
+			schema:   NewSchema(schemaId, SchemaTypeCL, []string{"name", "name"}, "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "attr_names: there should be no duplicates.",
+		},
+		{
+			name:     "negative: empty attribute",
+			schema:   NewSchema(schemaId, SchemaTypeCL, []string{"name", ""}, "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "attr_names: (1: cannot be blank.).",
+		},
+		{
+			name:     "negative: too many attributes",
+			schema:   NewSchema(schemaId, SchemaTypeCL, make([]string, MaxSchemaAttrNames+1), "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "attr_names: the length must be between 1 and 125.",
+		},
+		{
+			name:     "negative: no name and version",
+			schema:   NewSchema(schemaId, SchemaTypeCL, []string{"name"}, "", "", []string{controller}),
+			isValid:  false,
+			errorMsg: "name: cannot be blank; version: cannot be blank.",
+		},
+		{
+			name:     "negative: no controller",
+			schema:   NewSchema(schemaId, SchemaTypeCL, []string{"name"}, "Passport", "1.0", nil),
+			isValid:  false,
+			errorMsg: "controller: cannot be blank.",
+		},
+		{
+			name:     "negative: invalid id",
+			schema:   NewSchema("did:cheqd:test:ssss", SchemaTypeCL, []string{"name"}, "Passport", "1.0", []string{controller}),
+			isValid:  false,
+			errorMsg: "id: unique id length should be 16 or 32 symbols.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.Validate(tc.allowedNamespaces)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
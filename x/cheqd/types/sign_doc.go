@@ -0,0 +1,145 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	"github.com/gogo/protobuf/jsonpb"
+)
+
+const (
+	// SignDocVersionLegacy means that the payload alone is signed
+	SignDocVersionLegacy uint32 = 0
+	// SignDocVersion1 means that IdentitySignDoc wrapping the payload is signed
+	SignDocVersion1 uint32 = 1
+)
+
+func NewIdentitySignDoc(version uint32, chainId string, namespace string, msgType string, expiryHeight uint64, payload []byte) *IdentitySignDoc {
+	return &IdentitySignDoc{
+		Version:      version,
+		ChainId:      chainId,
+		Namespace:    namespace,
+		MsgType:      msgType,
+		ExpiryHeight: expiryHeight,
+		Payload:      payload,
+	}
+}
+
+func (doc *IdentitySignDoc) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(doc)
+}
+
+// identitySignDocJson is JSON form of IdentitySignDoc used in JCS mode. The payload is embedded as a JSON object.
+type identitySignDocJson struct {
+	Version      uint32          `json:"version"`
+	ChainId      string          `json:"chain_id"`
+	Namespace    string          `json:"namespace"`
+	MsgType      string          `json:"msg_type"`
+	ExpiryHeight uint64          `json:"expiry_height,omitempty,string"`
+	Payload      json.RawMessage `json:"payload"`
+}
+
+// MustGetSignJson returns the proto JSON representation of the payload that is canonicalized in JCS mode.
+// Field names are the original proto names, fields with default values are omitted.
+func MustGetSignJson(payload IdentityMsg) []byte {
+	var buf bytes.Buffer
+
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	if err := marshaler.Marshal(&buf, payload); err != nil {
+		panic(err)
+	}
+
+	return buf.Bytes()
+}
+
+// SignPayload holds everything except signature specific options that is needed to build sign bytes
+type SignPayload struct {
+	ChainId     string
+	Namespace   string
+	MsgType     string
+	Payload     []byte
+	PayloadJson []byte
+}
+
+// NewSignPayload serialises the payload in the modes used by the signatures.
+// The JSON form is built only if one of the signatures is in JCS mode.
+// It must be called before the payload is modified.
+func NewSignPayload(chainId string, namespace string, msgType string, payload IdentityMsg, signatures []*SignInfo) SignPayload {
+	withJson := false
+	for _, signature := range signatures {
+		if signature.SignBytesMode == SignBytesMode_SIGN_BYTES_MODE_JCS {
+			withJson = true
+			break
+		}
+	}
+
+	return newSignPayload(chainId, namespace, msgType, payload, withJson)
+}
+
+// NewSignPayloadForMode serialises the payload for signing in the given mode
+func NewSignPayloadForMode(chainId string, namespace string, msgType string, payload IdentityMsg, mode SignBytesMode) SignPayload {
+	return newSignPayload(chainId, namespace, msgType, payload, mode == SignBytesMode_SIGN_BYTES_MODE_JCS)
+}
+
+func newSignPayload(chainId string, namespace string, msgType string, payload IdentityMsg, withJson bool) SignPayload {
+	signPayload := SignPayload{
+		ChainId:   chainId,
+		Namespace: namespace,
+		MsgType:   msgType,
+		Payload:   payload.GetSignBytes(),
+	}
+
+	if withJson {
+		signPayload.PayloadJson = MustGetSignJson(payload)
+	}
+
+	return signPayload
+}
+
+// GetSignBytes returns bytes that are expected to be signed using the passed sign doc version, mode and expiry height
+func (sp SignPayload) GetSignBytes(signDocVersion uint32, mode SignBytesMode, expiryHeight uint64) ([]byte, error) {
+	switch mode {
+	case SignBytesMode_SIGN_BYTES_MODE_PROTO:
+		switch signDocVersion {
+		case SignDocVersionLegacy:
+			return sp.Payload, nil
+		case SignDocVersion1:
+			return NewIdentitySignDoc(signDocVersion, sp.ChainId, sp.Namespace, sp.MsgType, expiryHeight, sp.Payload).GetSignBytes(), nil
+		}
+
+	case SignBytesMode_SIGN_BYTES_MODE_JCS:
+		if sp.PayloadJson == nil {
+			return nil, fmt.Errorf("payload is not serialised for JCS mode")
+		}
+
+		// JCS mode has no legacy signers, so the payload is always bound to the network and the message type
+		switch signDocVersion {
+		case SignDocVersion1:
+			doc, err := json.Marshal(identitySignDocJson{
+				Version:      signDocVersion,
+				ChainId:      sp.ChainId,
+				Namespace:    sp.Namespace,
+				MsgType:      sp.MsgType,
+				ExpiryHeight: expiryHeight,
+				Payload:      sp.PayloadJson,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return utils.CanonicalizeJSON(doc)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported sign bytes mode: %s", mode)
+	}
+
+	return nil, fmt.Errorf("unsupported sign doc version: %d", signDocVersion)
+}
+
+// GetSignBytesForSignInfo returns bytes that are expected to be signed by the passed signature
+func (sp SignPayload) GetSignBytesForSignInfo(signInfo SignInfo) ([]byte, error) {
+	return sp.GetSignBytes(signInfo.SignDocVersion, signInfo.SignBytesMode, signInfo.ExpiryHeight)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignPayloadJson(t *testing.T) {
+	payload := &MsgCreateDidPayload{Id: ValidTestDID}
+
+	// The JSON form isn't built if nothing is signed in JCS mode
+	signPayload := NewSignPayload("test", "test", "msg", payload, []*SignInfo{{SignBytesMode: SignBytesMode_SIGN_BYTES_MODE_PROTO}})
+	require.Nil(t, signPayload.PayloadJson)
+
+	_, err := signPayload.GetSignBytes(SignDocVersion1, SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.Error(t, err)
+
+	signPayload = NewSignPayload("test", "test", "msg", payload, []*SignInfo{
+		{SignBytesMode: SignBytesMode_SIGN_BYTES_MODE_PROTO},
+		{SignBytesMode: SignBytesMode_SIGN_BYTES_MODE_JCS},
+	})
+	require.NotNil(t, signPayload.PayloadJson)
+
+	_, err = signPayload.GetSignBytes(SignDocVersion1, SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.NoError(t, err)
+
+	// Legacy sign docs are not supported in JCS mode
+	_, err = signPayload.GetSignBytes(SignDocVersionLegacy, SignBytesMode_SIGN_BYTES_MODE_JCS, 0)
+	require.EqualError(t, err, "unsupported sign doc version: 0")
+}
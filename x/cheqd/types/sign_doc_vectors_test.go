@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
+)
+
+// signBytesVector is a test vector shared with client SDKs.
+// Sign bytes are hex encoded, signatures are base64 encoded ed25519 signatures made with the key derived from the seed.
+type signBytesVector struct {
+	Name           string          `json:"name"`
+	ChainId        string          `json:"chain_id"`
+	Namespace      string          `json:"namespace"`
+	MsgType        string          `json:"msg_type"`
+	SignDocVersion uint32          `json:"sign_doc_version"`
+	SignBytesMode  string          `json:"sign_bytes_mode"`
+	ExpiryHeight   uint64          `json:"expiry_height,string"`
+	Payload        json.RawMessage `json:"payload"`
+	Seed           string          `json:"seed"`
+	SignBytes      string          `json:"sign_bytes"`
+	Signature      string          `json:"signature"`
+}
+
+func TestSignBytesVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/sign_bytes_vectors.json")
+	require.NoError(t, err)
+
+	var vectors []signBytesVector
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(v.Name, func(t *testing.T) {
+			var payload IdentityMsg
+			switch v.MsgType {
+			case "/cheqdid.cheqdnode.cheqd.v1.MsgCreateDid":
+				payload = &MsgCreateDidPayload{}
+			case "/cheqdid.cheqdnode.cheqd.v1.MsgUpdateDid":
+				payload = &MsgUpdateDidPayload{}
+			default:
+				t.Fatalf("unexpected msg type: %s", v.MsgType)
+			}
+
+			require.NoError(t, jsonpb.Unmarshal(bytes.NewReader(v.Payload), payload))
+
+			mode := SignBytesMode(SignBytesMode_value[v.SignBytesMode])
+			signPayload := NewSignPayloadForMode(v.ChainId, v.Namespace, v.MsgType, payload, mode)
+			signBytes, err := signPayload.GetSignBytes(v.SignDocVersion, mode, v.ExpiryHeight)
+			require.NoError(t, err)
+			require.Equal(t, v.SignBytes, hex.EncodeToString(signBytes))
+
+			seed, err := hex.DecodeString(v.Seed)
+			require.NoError(t, err)
+
+			signature := ed25519.Sign(ed25519.NewKeyFromSeed(seed), signBytes)
+			require.Equal(t, v.Signature, base64.StdEncoding.EncodeToString(signature))
+		})
+	}
+}
@@ -42,6 +42,21 @@ func (m *Metadata) Update(ctx sdk.Context) {
 	m.Updated = ctx.BlockTime().Format(time.RFC3339)
 }
 
+// UpdateVersion updates the metadata and gives it a new version id.
+// Updates of DID Docs, revocation registries and status lists must reference the current version id,
+// so rotating it on every write makes an applied update impossible to replay.
+func (m *Metadata) UpdateVersion(ctx sdk.Context) {
+	m.Update(ctx)
+	m.VersionId = NextVersionId(ctx, m.VersionId)
+}
+
+// NextVersionId returns the version id of the next version of an object.
+// It's the hash of the transaction hash and the current version id rather than the transaction hash alone,
+// so every message of a transaction that writes the same object creates a version with its own id.
+func NextVersionId(ctx sdk.Context, currentVersionId string) string {
+	return utils.GetTxHash([]byte(utils.GetTxHash(ctx.TxBytes()) + "/" + currentVersionId))
+}
+
 func (m StateValue) UnpackData() (StateValueData, error) {
 	value, isOk := m.Data.GetCachedValue().(StateValueData)
 	if !isOk {
@@ -64,3 +79,87 @@ func (m StateValue) UnpackDataAsDid() (*Did, error) {
 
 	return value, nil
 }
+
+func (m StateValue) UnpackDataAsSchema() (*Schema, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*Schema)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
+
+func (m StateValue) UnpackDataAsCredDef() (*CredDef, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*CredDef)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
+
+func (m StateValue) UnpackDataAsRevocRegDef() (*RevocRegDef, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*RevocRegDef)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
+
+func (m StateValue) UnpackDataAsRevocRegEntry() (*RevocRegEntry, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*RevocRegEntry)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
+
+func (m StateValue) UnpackDataAsStatusList() (*StatusList, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*StatusList)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
+
+func (m StateValue) UnpackDataAsResource() (*Resource, error) {
+	data, err := m.UnpackData()
+	if err != nil {
+		return nil, err
+	}
+
+	value, isValue := data.(*Resource)
+	if !isValue {
+		return nil, ErrUnpackStateValue.Wrap(reflect.TypeOf(data).String())
+	}
+
+	return value, nil
+}
@@ -81,6 +81,10 @@ type Metadata struct {
 	Updated     string `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`
 	Deactivated bool   `protobuf:"varint,3,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
 	VersionId   string `protobuf:"bytes,4,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// id of the governance proposal the DID Doc was deactivated by, 0 if it wasn't deactivated by governance
+	DeactivationProposalId uint64 `protobuf:"varint,5,opt,name=deactivation_proposal_id,json=deactivationProposalId,proto3" json:"deactivation_proposal_id,omitempty"`
+	// DID Doc fields cleared by the deactivation proposal
+	RedactedFields []string `protobuf:"bytes,6,rep,name=redacted_fields,json=redactedFields,proto3" json:"redacted_fields,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -144,6 +148,20 @@ func (m *Metadata) GetVersionId() string {
 	return ""
 }
 
+func (m *Metadata) GetDeactivationProposalId() uint64 {
+	if m != nil {
+		return m.DeactivationProposalId
+	}
+	return 0
+}
+
+func (m *Metadata) GetRedactedFields() []string {
+	if m != nil {
+		return m.RedactedFields
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StateValue)(nil), "cheqdid.cheqdnode.cheqd.v1.StateValue")
 	proto.RegisterType((*Metadata)(nil), "cheqdid.cheqdnode.cheqd.v1.Metadata")
@@ -152,25 +170,29 @@ func init() {
 func init() { proto.RegisterFile("cheqd/v1/stateValue.proto", fileDescriptor_7d27f952e1e87cef) }
 
 var fileDescriptor_7d27f952e1e87cef = []byte{
-	// 288 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0xeb, 0x52, 0x41, 0xeb, 0x6e, 0x16, 0x43, 0x5a, 0x09, 0x2b, 0xaa, 0x18, 0xc2, 0x80,
-	0xad, 0xc2, 0x0b, 0xf0, 0x67, 0x62, 0x60, 0x09, 0x12, 0x03, 0x0b, 0x72, 0xe2, 0x23, 0x8d, 0xd4,
-	0xc6, 0x21, 0x71, 0xa2, 0x66, 0xe2, 0x15, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0xd4,
-	0x4b, 0x52, 0xb1, 0xb0, 0xd8, 0xbe, 0xef, 0xfb, 0x7e, 0xb6, 0xef, 0xe8, 0x2c, 0x5c, 0xc1, 0xbb,
-	0x96, 0xe5, 0x52, 0xe6, 0x56, 0x59, 0x78, 0x56, 0xeb, 0x02, 0x44, 0x9a, 0x19, 0x6b, 0xd8, 0x1c,
-	0xad, 0x58, 0x0b, 0xdc, 0x13, 0xa3, 0xa1, 0x3d, 0x89, 0x72, 0x39, 0x9f, 0x45, 0xc6, 0x44, 0x6b,
-	0x90, 0x98, 0x0c, 0x8a, 0x37, 0xa9, 0x92, 0xaa, 0xc5, 0x16, 0x5b, 0x4a, 0x9f, 0x0e, 0x57, 0x31,
-	0x8f, 0x8e, 0xb4, 0xb2, 0xca, 0x21, 0x2e, 0xf1, 0xa6, 0x57, 0xa7, 0xa2, 0xe5, 0x44, 0xcf, 0x89,
-	0xdb, 0xa4, 0xf2, 0x31, 0xc1, 0x6e, 0xe8, 0x78, 0x03, 0x56, 0x61, 0x7a, 0x88, 0xe9, 0x73, 0xf1,
-	0xff, 0x0f, 0xc4, 0x63, 0x97, 0xf5, 0x0f, 0xd4, 0xe2, 0x83, 0x8e, 0x7b, 0x95, 0x39, 0xf4, 0x24,
-	0xcc, 0x40, 0x59, 0xd0, 0xf8, 0xf4, 0xc4, 0xef, 0xcb, 0xbd, 0x53, 0xa4, 0x1a, 0x9d, 0x61, 0xeb,
-	0x74, 0x25, 0x73, 0xe9, 0x54, 0x83, 0x0a, 0x6d, 0x5c, 0xa2, 0x7b, 0xe4, 0x12, 0x6f, 0xec, 0xff,
-	0x95, 0xd8, 0x19, 0xa5, 0x25, 0x64, 0x79, 0x6c, 0x92, 0xd7, 0x58, 0x3b, 0x23, 0xc4, 0x27, 0x9d,
-	0xf2, 0xa0, 0xef, 0xee, 0xbf, 0x6a, 0x4e, 0x76, 0x35, 0x27, 0x3f, 0x35, 0x27, 0x9f, 0x0d, 0x1f,
-	0xec, 0x1a, 0x3e, 0xf8, 0x6e, 0xf8, 0xe0, 0xe5, 0x22, 0x8a, 0xed, 0xaa, 0x08, 0x44, 0x68, 0x36,
-	0xb2, 0x9d, 0x38, 0xae, 0x97, 0xfb, 0x9e, 0xe4, 0xb6, 0x93, 0x6c, 0x95, 0x42, 0x1e, 0x1c, 0xe3,
-	0x6c, 0xae, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x10, 0xb8, 0x1e, 0x9a, 0x01, 0x00, 0x00,
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x86, 0x3b, 0x6d, 0xbf, 0x7e, 0xed, 0x14, 0x14, 0x06, 0x91, 0xb4, 0x60, 0x08, 0x45, 0x30,
+	0x2e, 0x9c, 0x50, 0xdd, 0xb8, 0xf4, 0x07, 0x84, 0x2e, 0x04, 0x89, 0xe0, 0xc2, 0x4d, 0x99, 0x66,
+	0x4e, 0xdb, 0x40, 0x9a, 0x89, 0xc9, 0x24, 0xb4, 0x77, 0xe1, 0x65, 0xb9, 0xec, 0xd2, 0xa5, 0xa4,
+	0x37, 0x22, 0x39, 0x49, 0x4a, 0x37, 0x6e, 0x92, 0x9c, 0xf7, 0x79, 0xde, 0x24, 0x9c, 0xa1, 0x03,
+	0x6f, 0x09, 0x1f, 0xd2, 0xc9, 0xc6, 0x4e, 0xa2, 0x85, 0x86, 0x37, 0x11, 0xa4, 0xc0, 0xa3, 0x58,
+	0x69, 0xc5, 0x86, 0x88, 0x7c, 0xc9, 0xf1, 0x1e, 0x2a, 0x09, 0xe5, 0x13, 0xcf, 0xc6, 0xc3, 0xc1,
+	0x42, 0xa9, 0x45, 0x00, 0x0e, 0x9a, 0xb3, 0x74, 0xee, 0x88, 0x70, 0x53, 0xd6, 0x46, 0x6b, 0x4a,
+	0x5f, 0xf7, 0xaf, 0x62, 0x36, 0x6d, 0x4b, 0xa1, 0x85, 0x41, 0x2c, 0x62, 0xf7, 0xaf, 0x4f, 0x78,
+	0xd9, 0xe3, 0x75, 0x8f, 0xdf, 0x87, 0x1b, 0x17, 0x0d, 0x76, 0x47, 0xbb, 0x2b, 0xd0, 0x02, 0xed,
+	0x26, 0xda, 0xe7, 0xfc, 0xef, 0x3f, 0xe0, 0xcf, 0x95, 0xeb, 0xee, 0x5b, 0xa3, 0x9c, 0xd0, 0x6e,
+	0x1d, 0x33, 0x83, 0xfe, 0xf7, 0x62, 0x10, 0x1a, 0x24, 0x7e, 0xbb, 0xe7, 0xd6, 0x63, 0x41, 0xd2,
+	0x48, 0x22, 0x69, 0x96, 0xa4, 0x1a, 0x99, 0x45, 0xfb, 0x12, 0x84, 0xa7, 0xfd, 0x0c, 0x69, 0xcb,
+	0x22, 0x76, 0xd7, 0x3d, 0x8c, 0xd8, 0x19, 0xa5, 0x19, 0xc4, 0x89, 0xaf, 0xc2, 0xa9, 0x2f, 0x8d,
+	0x36, 0xd6, 0x7b, 0x55, 0x32, 0x91, 0xec, 0x96, 0x1a, 0x7b, 0xbb, 0x70, 0xa2, 0x58, 0x45, 0x2a,
+	0x11, 0x41, 0x21, 0xff, 0xb3, 0x88, 0xdd, 0x76, 0x4f, 0x0f, 0xf9, 0x4b, 0x85, 0x27, 0x92, 0x5d,
+	0xd0, 0xe3, 0x18, 0xa4, 0xf0, 0x34, 0xc8, 0xe9, 0xdc, 0x87, 0x40, 0x26, 0x46, 0xc7, 0x6a, 0xd9,
+	0x3d, 0xf7, 0xa8, 0x8e, 0x9f, 0x30, 0x7d, 0x78, 0xfc, 0xca, 0x4d, 0xb2, 0xcd, 0x4d, 0xf2, 0x93,
+	0x9b, 0xe4, 0x73, 0x67, 0x36, 0xb6, 0x3b, 0xb3, 0xf1, 0xbd, 0x33, 0x1b, 0xef, 0x97, 0x0b, 0x5f,
+	0x2f, 0xd3, 0x19, 0xf7, 0xd4, 0xca, 0x29, 0x4f, 0x15, 0xaf, 0x57, 0xc5, 0xde, 0x9c, 0x75, 0x15,
+	0xe9, 0x4d, 0x04, 0xc9, 0xac, 0x83, 0xfb, 0xbf, 0xf9, 0x1d, 0x00, 0xec, 0x36, 0xe7, 0x8f, 0xfe,
+	0x01, 0x00, 0x00,
 }
 
 func (m *StateValue) Marshal() (dAtA []byte, err error) {
@@ -240,6 +262,20 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RedactedFields) > 0 {
+		for iNdEx := len(m.RedactedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedactedFields[iNdEx])
+			copy(dAtA[i:], m.RedactedFields[iNdEx])
+			i = encodeVarintStateValue(dAtA, i, uint64(len(m.RedactedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DeactivationProposalId != 0 {
+		i = encodeVarintStateValue(dAtA, i, uint64(m.DeactivationProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -323,6 +359,15 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStateValue(uint64(l))
 	}
+	if m.DeactivationProposalId != 0 {
+		n += 1 + sovStateValue(uint64(m.DeactivationProposalId))
+	}
+	if len(m.RedactedFields) > 0 {
+		for _, s := range m.RedactedFields {
+			l = len(s)
+			n += 1 + l + sovStateValue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -599,6 +644,57 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.VersionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivationProposalId", wireType)
+			}
+			m.DeactivationProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivationProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateValue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStateValue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateValue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedactedFields = append(m.RedactedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateValue(dAtA[iNdEx:])
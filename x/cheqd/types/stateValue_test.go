@@ -77,6 +77,33 @@ func Test_UpdateMetadata(t *testing.T) {
 	require.Equal(t, expectedMetadata, metadata)
 }
 
+func Test_UpdateMetadataVersion(t *testing.T) {
+	createdTime := time.Now()
+	updatedTime := createdTime.Add(time.Hour)
+
+	ctx1 := sdk.NewContext(nil, tmproto.Header{ChainID: "test_chain_id", Time: createdTime}, true, nil).WithTxBytes([]byte("create_tx"))
+	ctx2 := sdk.NewContext(nil, tmproto.Header{ChainID: "test_chain_id", Time: updatedTime}, true, nil).WithTxBytes([]byte("update_tx"))
+
+	metadata := NewMetadataFromContext(ctx1)
+	createdVersionId := metadata.VersionId
+
+	metadata.UpdateVersion(ctx2)
+
+	require.Equal(t, Metadata{
+		Created:     createdTime.UTC().Format(time.RFC3339),
+		Updated:     updatedTime.UTC().Format(time.RFC3339),
+		Deactivated: false,
+		VersionId:   utils.GetTxHash([]byte(utils.GetTxHash([]byte("update_tx")) + "/" + createdVersionId)),
+	}, metadata)
+
+	// The second update in the same transaction gets another version id
+	firstUpdateVersionId := metadata.VersionId
+	metadata.UpdateVersion(ctx2)
+
+	require.NotEqual(t, firstUpdateVersionId, metadata.VersionId)
+	require.NotEqual(t, createdVersionId, metadata.VersionId)
+}
+
 func NewContext(time time.Time, txBytes []byte) sdk.Context {
 	ctx := sdk.NewContext(nil, tmproto.Header{ChainID: "test_chain_id", Time: time}, true, nil)
 	ctx.WithTxBytes(txBytes)
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ StateValueData = &StatusList{}
+
+const (
+	StatusPurposeRevocation = "revocation"
+	StatusPurposeSuspension = "suspension"
+
+	// StatusListMaxSize is the maximum number of bits in a status list
+	StatusListMaxSize = 1 << 21
+
+	// StatusListChunkSize is the number of bytes in a stored chunk of a status list
+	StatusListChunkSize = 256
+)
+
+func NewStatusList(id string, owner string, statusPurpose string, size uint64) StatusList {
+	return StatusList{
+		Id:            id,
+		Owner:         owner,
+		StatusPurpose: statusPurpose,
+		Size_:         size,
+	}
+}
+
+// ChunkCount returns the number of chunks the bitstring of the list is split into
+func (list StatusList) ChunkCount() uint64 {
+	return (list.Size_/8 + StatusListChunkSize - 1) / StatusListChunkSize
+}
+
+// GetStatusListChunkPosition returns the chunk that holds the bit and the position of the bit in the chunk
+func GetStatusListChunkPosition(index uint64) (chunk uint64, bit uint64) {
+	return index / (StatusListChunkSize * 8), index % (StatusListChunkSize * 8)
+}
+
+// GetBit returns the bit of the bitstring. Bit 0 is the most significant bit of the first byte.
+func GetBit(bits []byte, index uint64) bool {
+	return bits[index/8]&(0x80>>(index%8)) != 0
+}
+
+// SetBit sets or clears the bit of the bitstring
+func SetBit(bits []byte, index uint64, value bool) {
+	if value {
+		bits[index/8] |= 0x80 >> (index % 8)
+	} else {
+		bits[index/8] &^= 0x80 >> (index % 8)
+	}
+}
+
+// EncodeStatusList returns the GZIP-compressed, base64 encoded bitstring as defined by StatusList2021
+func EncodeStatusList(bits []byte) (string, error) {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(bits); err != nil {
+		return "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Validation
+
+func (list StatusList) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&list,
+		validation.Field(&list.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&list.Owner, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&list.StatusPurpose, validation.Required, validation.In(StatusPurposeRevocation, StatusPurposeSuspension)),
+		validation.Field(&list.Size_, validation.Required, validation.Max(uint64(StatusListMaxSize)), IsMultipleOfRule(8)),
+	)
+}
+
+func IsMultipleOfRule(divisor uint64) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(uint64)
+		if !ok {
+			panic("IsMultipleOfRule must be only applied on uint64 properties")
+		}
+
+		if casted%divisor != 0 {
+			return fmt.Errorf("must be a multiple of %d", divisor)
+		}
+
+		return nil
+	})
+}
+
+// IsUniqueStatusListIndexListRule checks that the list of bit indices has no duplicates
+func IsUniqueStatusListIndexListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]uint64)
+		if !ok {
+			panic("IsUniqueStatusListIndexListRule must be only applied on uint64 array properties")
+		}
+
+		seen := make(map[uint64]bool, len(casted))
+		for _, index := range casted {
+			if seen[index] {
+				return errors.New("there should be no duplicates")
+			}
+
+			seen[index] = true
+		}
+
+		return nil
+	})
+}
+
+// IsDisjointStatusListIndexListRule checks that none of the bit indices is in the other list
+func IsDisjointStatusListIndexListRule(other []uint64) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]uint64)
+		if !ok {
+			panic("IsDisjointStatusListIndexListRule must be only applied on uint64 array properties")
+		}
+
+		otherSet := make(map[uint64]bool, len(other))
+		for _, index := range other {
+			otherSet[index] = true
+		}
+
+		for _, index := range casted {
+			if otherSet[index] {
+				return fmt.Errorf("index %d can't be both set and cleared", index)
+			}
+		}
+
+		return nil
+	})
+}
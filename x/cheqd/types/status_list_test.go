@@ -0,0 +1,143 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusListValidation(t *testing.T) {
+	const (
+		statusListId = "did:cheqd:test:tttttttttttttttt"
+		owner        = "did:cheqd:test:aaaaaaaaaaaaaaaa"
+	)
+
+	cases := []struct {
+		name       string
+		statusList StatusList
+		isValid    bool
+		errorMsg   string
+	}{
+		{
+			name:       "positive: revocation list",
+			statusList: NewStatusList(statusListId, owner, StatusPurposeRevocation, 131072),
+			isValid:    true,
+		},
+		{
+			name:       "positive: suspension list of the maximum size",
+			statusList: NewStatusList(statusListId, owner, StatusPurposeSuspension, StatusListMaxSize),
+			isValid:    true,
+		},
+		{
+			name:       "negative: unsupported purpose",
+			statusList: NewStatusList(statusListId, owner, "refresh", 131072),
+			isValid:    false,
+			errorMsg:   "status_purpose: must be a valid value.",
+		},
+		{
+			name:       "negative: size isn't a multiple of 8",
+			statusList: NewStatusList(statusListId, owner, StatusPurposeRevocation, 1001),
+			isValid:    false,
+			errorMsg:   "size: must be a multiple of 8.",
+		},
+		{
+			name:       "negative: size is too big",
+			statusList: NewStatusList(statusListId, owner, StatusPurposeRevocation, StatusListMaxSize+8),
+			isValid:    false,
+			errorMsg:   "size: must be no greater than 2097152.",
+		},
+		{
+			name:       "negative: no owner and size",
+			statusList: NewStatusList(statusListId, "", StatusPurposeRevocation, 0),
+			isValid:    false,
+			errorMsg:   "owner: cannot be blank; size: cannot be blank.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.statusList.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgUpdateStatusListPayloadValidation(t *testing.T) {
+	const statusListId = "did:cheqd:test:tttttttttttttttt"
+
+	cases := []struct {
+		name     string
+		payload  MsgUpdateStatusListPayload
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name:    "positive: set and clear",
+			payload: MsgUpdateStatusListPayload{Id: statusListId, VersionId: "version", Set: []uint64{0, 1}, Clear: []uint64{2}},
+			isValid: true,
+		},
+		{
+			name:     "negative: nothing to change",
+			payload:  MsgUpdateStatusListPayload{Id: statusListId, VersionId: "version"},
+			isValid:  false,
+			errorMsg: "at least one index must be set or cleared",
+		},
+		{
+			name:     "negative: version id is missing",
+			payload:  MsgUpdateStatusListPayload{Id: statusListId, Set: []uint64{0}},
+			isValid:  false,
+			errorMsg: "version_id: cannot be blank.",
+		},
+		{
+			name:     "negative: duplicated index",
+			payload:  MsgUpdateStatusListPayload{Id: statusListId, VersionId: "version", Set: []uint64{3, 3}},
+			isValid:  false,
+			errorMsg: "set: there should be no duplicates.",
+		},
+		{
+			name:     "negative: index is set and cleared",
+			payload:  MsgUpdateStatusListPayload{Id: statusListId, VersionId: "version", Set: []uint64{3}, Clear: []uint64{3}},
+			isValid:  false,
+			errorMsg: "clear: index 3 can't be both set and cleared.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.payload.Validate(nil)
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestStatusListBits(t *testing.T) {
+	bits := make([]byte, 2)
+
+	SetBit(bits, 0, true)
+	SetBit(bits, 15, true)
+	require.Equal(t, []byte{0x80, 0x01}, bits)
+	require.True(t, GetBit(bits, 0))
+	require.False(t, GetBit(bits, 1))
+
+	SetBit(bits, 0, false)
+	require.Equal(t, []byte{0x00, 0x01}, bits)
+
+	chunk, bit := GetStatusListChunkPosition(StatusListChunkSize*8 + 5)
+	require.Equal(t, uint64(1), chunk)
+	require.Equal(t, uint64(5), bit)
+
+	require.Equal(t, uint64(1), NewStatusList("", "", "", 8).ChunkCount())
+	require.Equal(t, uint64(64), NewStatusList("", "", "", 131072).ChunkCount())
+}
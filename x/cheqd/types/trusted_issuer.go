@@ -0,0 +1,219 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const MaxCredentialTypeLength = 256
+
+func NewTrustedIssuer(issuer, credentialType string, validFrom time.Time, validUntil *time.Time) TrustedIssuer {
+	res := TrustedIssuer{
+		Issuer:         issuer,
+		CredentialType: credentialType,
+		ValidFrom:      validFrom.UTC().Format(time.RFC3339),
+	}
+
+	if validUntil != nil {
+		res.ValidUntil = validUntil.UTC().Format(time.RFC3339)
+	}
+
+	return res
+}
+
+func NewTrustedIssuerRef(issuer, credentialType string) TrustedIssuerRef {
+	return TrustedIssuerRef{
+		Issuer:         issuer,
+		CredentialType: credentialType,
+	}
+}
+
+func (ti TrustedIssuer) Ref() TrustedIssuerRef {
+	return NewTrustedIssuerRef(ti.Issuer, ti.CredentialType)
+}
+
+// IsValidAt checks whether the accreditation is in effect at the given time
+func (ti TrustedIssuer) IsValidAt(t time.Time) bool {
+	validFrom, err := time.Parse(time.RFC3339, ti.ValidFrom)
+	if err != nil || t.Before(validFrom) {
+		return false
+	}
+
+	if ti.ValidUntil == "" {
+		return true
+	}
+
+	validUntil, err := time.Parse(time.RFC3339, ti.ValidUntil)
+	return err == nil && t.Before(validUntil)
+}
+
+// Helpers
+
+func GetTrustedIssuerRefs(trustedIssuers []*TrustedIssuer) []TrustedIssuerRef {
+	res := make([]TrustedIssuerRef, len(trustedIssuers))
+
+	for i := range trustedIssuers {
+		res[i] = trustedIssuers[i].Ref()
+	}
+
+	return res
+}
+
+func isUniqueTrustedIssuerRefs(refs []TrustedIssuerRef) bool {
+	set := map[TrustedIssuerRef]bool{}
+
+	for _, ref := range refs {
+		if set[ref] {
+			return false
+		}
+
+		set[ref] = true
+	}
+
+	return true
+}
+
+// Validation
+
+func (ti TrustedIssuer) Validate() error {
+	return validation.ValidateStruct(&ti,
+		validation.Field(&ti.Issuer, validation.Required, IsDID(nil)),
+		validation.Field(&ti.CredentialType, validation.Required, IsCredentialType()),
+		validation.Field(&ti.ValidFrom, validation.Required, IsRFC3339Time()),
+		validation.Field(&ti.ValidUntil, IsRFC3339Time(), IsAfterRFC3339Time(ti.ValidFrom)),
+	)
+}
+
+func (ref TrustedIssuerRef) Validate() error {
+	return validation.ValidateStruct(&ref,
+		validation.Field(&ref.Issuer, validation.Required, IsDID(nil)),
+		validation.Field(&ref.CredentialType, validation.Required, IsCredentialType()),
+	)
+}
+
+func ValidTrustedIssuerRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(TrustedIssuer)
+		if !ok {
+			panic("ValidTrustedIssuerRule must be only applied on trusted issuers")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func ValidTrustedIssuerRefRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(TrustedIssuerRef)
+		if !ok {
+			panic("ValidTrustedIssuerRefRule must be only applied on trusted issuer refs")
+		}
+
+		return casted.Validate()
+	})
+}
+
+func IsUniqueTrustedIssuerListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*TrustedIssuer)
+		if !ok {
+			panic("IsUniqueTrustedIssuerListRule must be only applied on trusted issuer lists")
+		}
+
+		if !isUniqueTrustedIssuerRefs(GetTrustedIssuerRefs(casted)) {
+			return errors.New("there should be no duplicates")
+		}
+
+		return nil
+	})
+}
+
+func IsUniqueTrustedIssuerRefListRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*TrustedIssuerRef)
+		if !ok {
+			panic("IsUniqueTrustedIssuerRefListRule must be only applied on trusted issuer ref lists")
+		}
+
+		refs := make([]TrustedIssuerRef, len(casted))
+		for i := range casted {
+			refs[i] = *casted[i]
+		}
+
+		if !isUniqueTrustedIssuerRefs(refs) {
+			return errors.New("there should be no duplicates")
+		}
+
+		return nil
+	})
+}
+
+// IsCredentialType checks that the value can be used as a credential type, i.e. a JSON-LD term or an IRI.
+// Whitespaces are not allowed, as they separate credential types from issuers in the store keys.
+func IsCredentialType() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsCredentialType must be only applied on string properties")
+		}
+
+		if len(casted) > MaxCredentialTypeLength {
+			return errors.New("credential type is too long")
+		}
+
+		if strings.IndexFunc(casted, func(r rune) bool { return unicode.IsSpace(r) || !unicode.IsPrint(r) }) != -1 {
+			return errors.New("credential type must not contain whitespaces or control characters")
+		}
+
+		return nil
+	})
+}
+
+func IsRFC3339Time() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsRFC3339Time must be only applied on string properties")
+		}
+
+		if casted == "" {
+			return nil
+		}
+
+		if _, err := time.Parse(time.RFC3339, casted); err != nil {
+			return errors.New("must be a RFC3339 time")
+		}
+
+		return nil
+	})
+}
+
+// IsAfterRFC3339Time checks that the time is after the given one. Invalid times are skipped.
+func IsAfterRFC3339Time(other string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsAfterRFC3339Time must be only applied on string properties")
+		}
+
+		t, err := time.Parse(time.RFC3339, casted)
+		if err != nil {
+			return nil
+		}
+
+		o, err := time.Parse(time.RFC3339, other)
+		if err != nil {
+			return nil
+		}
+
+		if !t.After(o) {
+			return errors.New("must be after " + other)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustedIssuerValidation(t *testing.T) {
+	cases := []struct {
+		name          string
+		trustedIssuer TrustedIssuer
+		isValid       bool
+		errorMsg      string
+	}{
+		{
+			name:          "positive: no end of the validity window",
+			trustedIssuer: TrustedIssuer{Issuer: "did:cheqd:test:aaaaaaaaaaaaaaaa", CredentialType: "UniversityDegreeCredential", ValidFrom: "2022-01-01T00:00:00Z"},
+			isValid:       true,
+		},
+		{
+			name:          "positive: IRI credential type and validity window",
+			trustedIssuer: TrustedIssuer{Issuer: "did:cheqd:test:aaaaaaaaaaaaaaaa", CredentialType: "https://example.com/credentials#Diploma", ValidFrom: "2022-01-01T00:00:00Z", ValidUntil: "2023-01-01T00:00:00Z"},
+			isValid:       true,
+		},
+		{
+			name:          "negative: invalid issuer",
+			trustedIssuer: TrustedIssuer{Issuer: "did:example:aaaaaaaaaaaaaaaa", CredentialType: "UniversityDegreeCredential", ValidFrom: "2022-01-01T00:00:00Z"},
+			isValid:       false,
+			errorMsg:      "issuer: did method must be: cheqd.",
+		},
+		{
+			name:          "negative: no credential type",
+			trustedIssuer: TrustedIssuer{Issuer: "did:cheqd:test:aaaaaaaaaaaaaaaa", ValidFrom: "2022-01-01T00:00:00Z"},
+			isValid:       false,
+			errorMsg:      "credential_type: cannot be blank.",
+		},
+		{
+			name:          "negative: invalid time",
+			trustedIssuer: TrustedIssuer{Issuer: "did:cheqd:test:aaaaaaaaaaaaaaaa", CredentialType: "UniversityDegreeCredential", ValidFrom: "2022-01-01"},
+			isValid:       false,
+			errorMsg:      "valid_from: must be a RFC3339 time.",
+		},
+		{
+			name:          "negative: empty validity window",
+			trustedIssuer: TrustedIssuer{Issuer: "did:cheqd:test:aaaaaaaaaaaaaaaa", CredentialType: "UniversityDegreeCredential", ValidFrom: "2022-01-01T00:00:00Z", ValidUntil: "2022-01-01T00:00:00Z"},
+			isValid:       false,
+			errorMsg:      "valid_until: must be after 2022-01-01T00:00:00Z.",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.trustedIssuer.Validate()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestTrustedIssuerIsValidAt(t *testing.T) {
+	validFrom, _ := time.Parse(time.RFC3339, "2022-01-01T00:00:00Z")
+	validUntil := validFrom.AddDate(1, 0, 0)
+
+	trustedIssuer := NewTrustedIssuer("did:cheqd:test:aaaaaaaaaaaaaaaa", "UniversityDegreeCredential", validFrom, &validUntil)
+
+	require.False(t, trustedIssuer.IsValidAt(validFrom.Add(-time.Second)))
+	require.True(t, trustedIssuer.IsValidAt(validFrom))
+	require.True(t, trustedIssuer.IsValidAt(validUntil.Add(-time.Second)))
+	require.False(t, trustedIssuer.IsValidAt(validUntil))
+}
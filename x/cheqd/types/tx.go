@@ -1,5 +1,8 @@
 package types
 
+import "github.com/gogo/protobuf/proto"
+
 type IdentityMsg interface {
+	proto.Message
 	GetSignBytes() []byte
 }
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgClaimPayment{}
+
+func NewMsgClaimPayment(payload *MsgClaimPaymentPayload, signatures []*SignInfo) *MsgClaimPayment {
+	return &MsgClaimPayment{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgClaimPayment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimPayment) Type() string {
+	return "MsgClaimPayment"
+}
+
+func (msg *MsgClaimPayment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgClaimPayment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimPayment) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgClaimPayment) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgClaimPaymentPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
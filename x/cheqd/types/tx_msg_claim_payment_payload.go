@@ -0,0 +1,33 @@
+package types
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgClaimPaymentPayload{}
+
+func (msg *MsgClaimPaymentPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// Validation
+
+func (msg MsgClaimPaymentPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.RequestId, validation.Required, validation.Length(1, EscrowRequestIdMaxLength)),
+		validation.Field(&msg.Recipient, validation.Required, IsBech32Address()),
+		validation.Field(&msg.Issuer, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.Holder, validation.Required, IsBech32Address()),
+	)
+}
+
+func ValidMsgClaimPaymentPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgClaimPaymentPayload)
+		if !ok {
+			panic("ValidMsgClaimPaymentPayloadRule must be only applied on MsgClaimPaymentPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
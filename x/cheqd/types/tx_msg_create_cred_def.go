@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgCreateCredDef{}
+
+func NewMsgCreateCredDef(payload *MsgCreateCredDefPayload, signatures []*SignInfo) *MsgCreateCredDef {
+	return &MsgCreateCredDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateCredDef) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateCredDef) Type() string {
+	return "MsgCreateCredDef"
+}
+
+func (msg *MsgCreateCredDef) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateCredDef) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateCredDef) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgCreateCredDef) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgCreateCredDefPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
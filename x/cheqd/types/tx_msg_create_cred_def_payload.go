@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateCredDefPayload{}
+
+func (msg *MsgCreateCredDefPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateCredDefPayload) ToCredDef() CredDef {
+	return NewCredDef(msg.Id, msg.SchemaId, msg.SignatureType, msg.Tag, msg.Value, msg.Controller)
+}
+
+// Validation
+
+func (msg MsgCreateCredDefPayload) Validate(allowedNamespaces []string) error {
+	return msg.ToCredDef().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateCredDefPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateCredDefPayload)
+		if !ok {
+			panic("ValidMsgCreateCredDefPayloadRule must be only applied on MsgCreateCredDefPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgCreateDidBatch{}
+
+func NewMsgCreateDidBatch(payload *MsgCreateDidBatchPayload, signatures []*SignInfo) *MsgCreateDidBatch {
+	return &MsgCreateDidBatch{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateDidBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateDidBatch) Type() string {
+	return "MsgCreateDidBatch"
+}
+
+func (msg *MsgCreateDidBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateDidBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateDidBatch) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgCreateDidBatch) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgCreateDidBatchPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgCreateDidBatchPayload{}
+
+func (msg *MsgCreateDidBatchPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateDidBatchPayload) ToDids() []Did {
+	res := make([]Did, len(msg.Dids))
+
+	for i := range msg.Dids {
+		res[i] = msg.Dids[i].ToDid()
+	}
+
+	return res
+}
+
+func (msg *MsgCreateDidBatchPayload) GetDidIds() []string {
+	res := make([]string, len(msg.Dids))
+
+	for i := range msg.Dids {
+		res[i] = msg.Dids[i].Id
+	}
+
+	return res
+}
+
+// Validation
+
+func (msg MsgCreateDidBatchPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Dids,
+			validation.Required, IsUniqueDidBatchRule(), validation.Each(ValidDidBatchEntryRule(allowedNamespaces)),
+		),
+	)
+}
+
+func ValidMsgCreateDidBatchPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateDidBatchPayload)
+		if !ok {
+			panic("ValidMsgCreateDidBatchPayloadRule must be only applied on MsgCreateDidBatchPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+func ValidDidBatchEntryRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(MsgCreateDidPayload)
+		if !ok {
+			panic("ValidDidBatchEntryRule must be only applied on MsgCreateDidPayload list items")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
+
+func IsUniqueDidBatchRule() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.([]*MsgCreateDidPayload)
+		if !ok {
+			panic("IsUniqueDidBatchRule must be only applied on MsgCreateDidPayload lists")
+		}
+
+		ids := make([]string, len(casted))
+		for i := range casted {
+			ids[i] = casted[i].Id
+		}
+
+		if !utils.IsUnique(ids) {
+			return errors.New("there are DID duplicates")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateDidBatchValidation(t *testing.T) {
+	newPayload := func(id string, controller ...string) *MsgCreateDidPayload {
+		return &MsgCreateDidPayload{
+			Id:         id,
+			Controller: controller,
+			VerificationMethod: []*VerificationMethod{
+				{
+					Id:                 id + "#key1",
+					Type:               "Ed25519VerificationKey2020",
+					Controller:         id,
+					PublicKeyMultibase: ValidEd25519PubKey,
+				},
+			},
+			Authentication: []string{id + "#key1"},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		struct_  *MsgCreateDidBatch
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name: "positive",
+			struct_: &MsgCreateDidBatch{
+				Payload: &MsgCreateDidBatchPayload{
+					Dids: []*MsgCreateDidPayload{
+						newPayload("did:cheqd:testnet:123456789abcdefg"),
+						newPayload("did:cheqd:testnet:gfedcba987654321", "did:cheqd:testnet:123456789abcdefg"),
+					},
+				},
+				Signatures: nil,
+			},
+			isValid: true,
+		},
+		{
+			name: "negative: dids are required",
+			struct_: &MsgCreateDidBatch{
+				Payload:    &MsgCreateDidBatchPayload{},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (dids: cannot be blank.).: basic validation failed",
+		},
+		{
+			name: "negative: duplicated dids",
+			struct_: &MsgCreateDidBatch{
+				Payload: &MsgCreateDidBatchPayload{
+					Dids: []*MsgCreateDidPayload{
+						newPayload("did:cheqd:testnet:123456789abcdefg"),
+						newPayload("did:cheqd:testnet:123456789abcdefg"),
+					},
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (dids: there are DID duplicates.).: basic validation failed",
+		},
+		{
+			name: "negative: invalid did in the batch",
+			struct_: &MsgCreateDidBatch{
+				Payload: &MsgCreateDidBatchPayload{
+					Dids: []*MsgCreateDidPayload{
+						newPayload("did:cheqd:testnet:123456789abcdefg"),
+						newPayload("did:cheqd:testnet:gfedcba987654321", "did:cheqd:testnet:123456789abcdefg", "did:cheqd:testnet:123456789abcdefg"),
+					},
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (dids: (1: (controller: there should be no duplicates.).).).: basic validation failed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.struct_.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateResourcePayload{}
+
+func (msg *MsgCreateResourcePayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateResourcePayload) ToResource() Resource {
+	return NewResource(msg.CollectionId, msg.Id, msg.Name, msg.ResourceType, msg.MediaType, msg.Data)
+}
+
+// Validation
+
+func (msg MsgCreateResourcePayload) Validate(allowedNamespaces []string) error {
+	return msg.ToResource().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateResourcePayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateResourcePayload)
+		if !ok {
+			panic("ValidMsgCreateResourcePayloadRule must be only applied on MsgCreateResourcePayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
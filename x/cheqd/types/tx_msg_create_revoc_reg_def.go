@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgCreateRevocRegDef{}
+
+func NewMsgCreateRevocRegDef(payload *MsgCreateRevocRegDefPayload, signatures []*SignInfo) *MsgCreateRevocRegDef {
+	return &MsgCreateRevocRegDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateRevocRegDef) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRevocRegDef) Type() string {
+	return "MsgCreateRevocRegDef"
+}
+
+func (msg *MsgCreateRevocRegDef) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateRevocRegDef) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRevocRegDef) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgCreateRevocRegDef) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgCreateRevocRegDefPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
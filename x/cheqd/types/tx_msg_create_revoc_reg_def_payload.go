@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateRevocRegDefPayload{}
+
+func (msg *MsgCreateRevocRegDefPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateRevocRegDefPayload) ToRevocRegDef() RevocRegDef {
+	return NewRevocRegDef(msg.Id, msg.Type, msg.CredDefId, msg.Tag, msg.Value, msg.Controller)
+}
+
+// Validation
+
+func (msg MsgCreateRevocRegDefPayload) Validate(allowedNamespaces []string) error {
+	return msg.ToRevocRegDef().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateRevocRegDefPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateRevocRegDefPayload)
+		if !ok {
+			panic("ValidMsgCreateRevocRegDefPayloadRule must be only applied on MsgCreateRevocRegDefPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
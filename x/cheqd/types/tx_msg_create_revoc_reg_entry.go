@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgCreateRevocRegEntry{}
+
+func NewMsgCreateRevocRegEntry(payload *MsgCreateRevocRegEntryPayload, signatures []*SignInfo) *MsgCreateRevocRegEntry {
+	return &MsgCreateRevocRegEntry{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgCreateRevocRegEntry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateRevocRegEntry) Type() string {
+	return "MsgCreateRevocRegEntry"
+}
+
+func (msg *MsgCreateRevocRegEntry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgCreateRevocRegEntry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateRevocRegEntry) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgCreateRevocRegEntry) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgCreateRevocRegEntryPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
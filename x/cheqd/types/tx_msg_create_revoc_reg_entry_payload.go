@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateRevocRegEntryPayload{}
+
+func (msg *MsgCreateRevocRegEntryPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateRevocRegEntryPayload) ToRevocRegEntry() RevocRegEntry {
+	return NewRevocRegEntry(msg.RevocRegDefId, "", msg.Accum, msg.Issued, msg.Revoked)
+}
+
+// Validation
+
+func (msg MsgCreateRevocRegEntryPayload) Validate(allowedNamespaces []string) error {
+	return msg.ToRevocRegEntry().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateRevocRegEntryPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateRevocRegEntryPayload)
+		if !ok {
+			panic("ValidMsgCreateRevocRegEntryPayloadRule must be only applied on MsgCreateRevocRegEntryPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
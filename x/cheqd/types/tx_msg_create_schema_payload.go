@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateSchemaPayload{}
+
+func (msg *MsgCreateSchemaPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateSchemaPayload) ToSchema() Schema {
+	return NewSchema(msg.Id, msg.Type, msg.AttrNames, msg.Name, msg.Version, msg.Controller)
+}
+
+// Validation
+
+func (msg MsgCreateSchemaPayload) Validate(allowedNamespaces []string) error {
+	return msg.ToSchema().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateSchemaPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateSchemaPayload)
+		if !ok {
+			panic("ValidMsgCreateSchemaPayloadRule must be only applied on MsgCreateSchemaPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,28 @@
+package types
+
+var _ IdentityMsg = &MsgCreateStatusListPayload{}
+
+func (msg *MsgCreateStatusListPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgCreateStatusListPayload) ToStatusList() StatusList {
+	return NewStatusList(msg.Id, msg.Owner, msg.StatusPurpose, msg.Size_)
+}
+
+// Validation
+
+func (msg MsgCreateStatusListPayload) Validate(allowedNamespaces []string) error {
+	return msg.ToStatusList().Validate(allowedNamespaces)
+}
+
+func ValidMsgCreateStatusListPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgCreateStatusListPayload)
+		if !ok {
+			panic("ValidMsgCreateStatusListPayloadRule must be only applied on MsgCreateStatusListPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgLockPayment{}
+
+func NewMsgLockPayment(holder string, requestId string, issuer string, amount sdk.Coin, timeout uint64) *MsgLockPayment {
+	return &MsgLockPayment{
+		Holder:    holder,
+		RequestId: requestId,
+		Issuer:    issuer,
+		Amount:    &amount,
+		Timeout:   timeout,
+	}
+}
+
+func (msg *MsgLockPayment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLockPayment) Type() string {
+	return "MsgLockPayment"
+}
+
+// GetSigners returns the holder, the payment is taken from the holder account
+func (msg *MsgLockPayment) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgLockPayment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLockPayment) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgLockPayment) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Holder, validation.Required, IsBech32Address()),
+		validation.Field(&msg.RequestId, validation.Required, validation.Length(1, EscrowRequestIdMaxLength)),
+		validation.Field(&msg.Issuer, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.Amount, ValidPaymentAmountRule()),
+		validation.Field(&msg.Timeout, validation.Required, validation.Min(uint64(EscrowMinTimeout)), validation.Max(uint64(EscrowMaxTimeout))),
+	)
+}
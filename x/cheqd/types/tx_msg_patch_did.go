@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgPatchDid{}
+
+func NewMsgPatchDid(payload *MsgPatchDidPayload, signatures []*SignInfo) *MsgPatchDid {
+	return &MsgPatchDid{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgPatchDid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPatchDid) Type() string {
+	return "MsgPatchDid"
+}
+
+func (msg *MsgPatchDid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgPatchDid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPatchDid) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgPatchDid) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgPatchDidPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, IsUniqueSignInfoListRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gogo/protobuf/proto"
+)
+
+var _ IdentityMsg = &MsgPatchDidPayload{}
+
+func (msg *MsgPatchDidPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// ApplyTo returns a copy of the did with all the operations applied in order.
+// The passed did is not modified.
+func (msg *MsgPatchDidPayload) ApplyTo(did Did) (Did, error) {
+	patched := proto.Clone(&did).(*Did)
+
+	for i, op := range msg.Operations {
+		if err := op.Apply(patched); err != nil {
+			return Did{}, ErrInvalidPatchOperation.Wrapf("operation %d: %s", i, err.Error())
+		}
+	}
+
+	return *patched, nil
+}
+
+// Validation
+
+func (msg MsgPatchDidPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.VersionId, validation.Required),
+		validation.Field(&msg.Operations, validation.Required),
+	)
+}
+
+func ValidMsgPatchDidPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgPatchDidPayload)
+		if !ok {
+			panic("ValidMsgPatchDidPayloadRule must be only applied on MsgPatchDidPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPatchDidValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		struct_  *MsgPatchDid
+		isValid  bool
+		errorMsg string
+	}{
+		{
+			name: "positive",
+			struct_: &MsgPatchDid{
+				Payload: &MsgPatchDidPayload{
+					Id:        "did:cheqd:testnet:123456789abcdefg",
+					VersionId: "version1",
+					Operations: []*DidPatchOperation{
+						{
+							Op:    PatchOpAdd,
+							Field: PatchFieldVerificationMethod,
+							VerificationMethod: &VerificationMethod{
+								Id:                 "did:cheqd:testnet:123456789abcdefg#key1",
+								Type:               "Ed25519VerificationKey2020",
+								Controller:         "did:cheqd:testnet:123456789abcdefg",
+								PublicKeyMultibase: ValidEd25519PubKey,
+							},
+						},
+						{
+							Op:    PatchOpRemove,
+							Field: PatchFieldAuthentication,
+							Value: "did:cheqd:testnet:123456789abcdefg#key2",
+						},
+					},
+				},
+				Signatures: nil,
+			},
+			isValid: true,
+		},
+		{
+			name: "negative: operations are required",
+			struct_: &MsgPatchDid{
+				Payload: &MsgPatchDidPayload{
+					Id:        "did:cheqd:testnet:123456789abcdefg",
+					VersionId: "version1",
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (operations: cannot be blank.).: basic validation failed",
+		},
+		{
+			name: "negative: version id is required",
+			struct_: &MsgPatchDid{
+				Payload: &MsgPatchDidPayload{
+					Id: "did:cheqd:testnet:123456789abcdefg",
+					Operations: []*DidPatchOperation{
+						{Op: PatchOpRemove, Field: PatchFieldAuthentication, Value: "did:cheqd:testnet:123456789abcdefg#key2"},
+					},
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (version_id: cannot be blank.).: basic validation failed",
+		},
+		{
+			name: "negative: unsupported field",
+			struct_: &MsgPatchDid{
+				Payload: &MsgPatchDidPayload{
+					Id:        "did:cheqd:testnet:123456789abcdefg",
+					VersionId: "version1",
+					Operations: []*DidPatchOperation{
+						{
+							Op:    PatchOpAdd,
+							Field: "id",
+							Value: "did:cheqd:testnet:gfedcba987654321",
+						},
+					},
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (operations: (0: (field: must be a valid value.).).).: basic validation failed",
+		},
+		{
+			name: "negative: verification method is required to add it",
+			struct_: &MsgPatchDid{
+				Payload: &MsgPatchDidPayload{
+					Id:        "did:cheqd:testnet:123456789abcdefg",
+					VersionId: "version1",
+					Operations: []*DidPatchOperation{
+						{
+							Op:    PatchOpAdd,
+							Field: PatchFieldVerificationMethod,
+							Value: "did:cheqd:testnet:123456789abcdefg#key1",
+						},
+					},
+				},
+				Signatures: nil,
+			},
+			isValid:  false,
+			errorMsg: "payload: (operations: (0: (value: must be blank; verification_method: cannot be blank.).).).: basic validation failed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.struct_.ValidateBasic()
+
+			if tc.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}
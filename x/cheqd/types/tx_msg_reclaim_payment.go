@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgReclaimPayment{}
+
+func NewMsgReclaimPayment(holder string, requestId string, issuer string) *MsgReclaimPayment {
+	return &MsgReclaimPayment{
+		Holder:    holder,
+		RequestId: requestId,
+		Issuer:    issuer,
+	}
+}
+
+func (msg *MsgReclaimPayment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReclaimPayment) Type() string {
+	return "MsgReclaimPayment"
+}
+
+// GetSigners returns the holder, only the account that locked the payment can reclaim it
+func (msg *MsgReclaimPayment) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgReclaimPayment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReclaimPayment) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgReclaimPayment) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Holder, validation.Required, IsBech32Address()),
+		validation.Field(&msg.RequestId, validation.Required, validation.Length(1, EscrowRequestIdMaxLength)),
+		validation.Field(&msg.Issuer, validation.Required, IsDID(allowedNamespaces)),
+	)
+}
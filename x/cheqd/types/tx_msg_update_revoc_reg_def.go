@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgUpdateRevocRegDef{}
+
+func NewMsgUpdateRevocRegDef(payload *MsgUpdateRevocRegDefPayload, signatures []*SignInfo) *MsgUpdateRevocRegDef {
+	return &MsgUpdateRevocRegDef{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgUpdateRevocRegDef) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRevocRegDef) Type() string {
+	return "MsgUpdateRevocRegDef"
+}
+
+func (msg *MsgUpdateRevocRegDef) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgUpdateRevocRegDef) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRevocRegDef) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgUpdateRevocRegDef) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgUpdateRevocRegDefPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
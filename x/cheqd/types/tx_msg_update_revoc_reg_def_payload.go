@@ -0,0 +1,32 @@
+package types
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgUpdateRevocRegDefPayload{}
+
+func (msg *MsgUpdateRevocRegDefPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// Validation
+
+func (msg MsgUpdateRevocRegDefPayload) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.Value, validation.Required, ValidRevocRegDefValueRule()),
+		validation.Field(&msg.VersionId, validation.Required),
+	)
+}
+
+func ValidMsgUpdateRevocRegDefPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgUpdateRevocRegDefPayload)
+		if !ok {
+			panic("ValidMsgUpdateRevocRegDefPayloadRule must be only applied on MsgUpdateRevocRegDefPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ sdk.Msg = &MsgUpdateRevocRegEntry{}
+
+func NewMsgUpdateRevocRegEntry(payload *MsgUpdateRevocRegEntryPayload, signatures []*SignInfo) *MsgUpdateRevocRegEntry {
+	return &MsgUpdateRevocRegEntry{
+		Payload:    payload,
+		Signatures: signatures,
+	}
+}
+
+func (msg *MsgUpdateRevocRegEntry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRevocRegEntry) Type() string {
+	return "MsgUpdateRevocRegEntry"
+}
+
+func (msg *MsgUpdateRevocRegEntry) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+func (msg *MsgUpdateRevocRegEntry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshal(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRevocRegEntry) ValidateBasic() error {
+	err := msg.Validate(nil)
+	if err != nil {
+		return ErrBasicValidation.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// Validate
+
+func (msg MsgUpdateRevocRegEntry) Validate(allowedNamespaces []string) error {
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Payload, validation.Required, ValidMsgUpdateRevocRegEntryPayloadRule(allowedNamespaces)),
+		validation.Field(&msg.Signatures, validation.Required, IsUniqueSignInfoListByIdRule(), validation.Each(ValidSignInfoRule(allowedNamespaces))),
+	)
+}
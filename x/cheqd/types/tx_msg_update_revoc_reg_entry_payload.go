@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+)
+
+var _ IdentityMsg = &MsgUpdateRevocRegEntryPayload{}
+
+func (msg *MsgUpdateRevocRegEntryPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgUpdateRevocRegEntryPayload) ToRevocRegEntry() RevocRegEntry {
+	return NewRevocRegEntry(msg.RevocRegDefId, msg.PrevAccum, msg.Accum, msg.Issued, msg.Revoked)
+}
+
+// Validation
+
+func (msg MsgUpdateRevocRegEntryPayload) Validate(allowedNamespaces []string) error {
+	if msg.PrevAccum == "" {
+		return errors.New("prev_accum: cannot be blank")
+	}
+
+	return msg.ToRevocRegEntry().Validate(allowedNamespaces)
+}
+
+func ValidMsgUpdateRevocRegEntryPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgUpdateRevocRegEntryPayload)
+		if !ok {
+			panic("ValidMsgUpdateRevocRegEntryPayloadRule must be only applied on MsgUpdateRevocRegEntryPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
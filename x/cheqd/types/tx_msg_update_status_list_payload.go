@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var _ IdentityMsg = &MsgUpdateStatusListPayload{}
+
+func (msg *MsgUpdateStatusListPayload) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// Validation
+
+func (msg MsgUpdateStatusListPayload) Validate(allowedNamespaces []string) error {
+	if len(msg.Set) == 0 && len(msg.Clear) == 0 {
+		return errors.New("at least one index must be set or cleared")
+	}
+
+	return validation.ValidateStruct(&msg,
+		validation.Field(&msg.Id, validation.Required, IsDID(allowedNamespaces)),
+		validation.Field(&msg.Set, IsUniqueStatusListIndexListRule()),
+		validation.Field(&msg.Clear, IsUniqueStatusListIndexListRule(), IsDisjointStatusListIndexListRule(msg.Set)),
+		validation.Field(&msg.VersionId, validation.Required),
+	)
+}
+
+func ValidMsgUpdateStatusListPayloadRule(allowedNamespaces []string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(*MsgUpdateStatusListPayload)
+		if !ok {
+			panic("ValidMsgUpdateStatusListPayloadRule must be only applied on MsgUpdateStatusListPayload properties")
+		}
+
+		return casted.Validate(allowedNamespaces)
+	})
+}
@@ -62,12 +62,31 @@ func FindSignInfoBySigner(infos []*SignInfo, signer string) (info SignInfo, foun
 	return infos_[0], true
 }
 
+// FindSignInfoByVerificationMethodId returns the first sign info made by the provided verification method
+func FindSignInfoByVerificationMethodId(infos []*SignInfo, verificationMethodId string) (info SignInfo, found bool) {
+	for _, info := range infos {
+		if info.VerificationMethodId == verificationMethodId {
+			return *info, true
+		}
+	}
+
+	return SignInfo{}, false
+}
+
 // Validate
 
 func (si SignInfo) Validate(allowedNamespaces []string) error {
 	return validation.ValidateStruct(&si,
 		validation.Field(&si.VerificationMethodId, validation.Required, IsDIDUrl(allowedNamespaces, Empty, Empty, Required)),
-		validation.Field(&si.Signature, validation.Required, is.Base64),
+		validation.Field(&si.Signature, validation.Required,
+			validation.When(si.SignatureFormat == SignatureFormat_SIGNATURE_FORMAT_JWS, IsDetachedJWS(si.VerificationMethodId)).Else(is.Base64),
+		),
+		validation.Field(&si.SignDocVersion, validation.In(SignDocVersionLegacy, SignDocVersion1),
+			validation.When(si.SignBytesMode == SignBytesMode_SIGN_BYTES_MODE_JCS, validation.Required.Error("must be 1 in JCS mode")),
+		),
+		validation.Field(&si.ExpiryHeight, validation.When(si.SignDocVersion == SignDocVersionLegacy, validation.Empty)),
+		validation.Field(&si.SignBytesMode, validation.In(SignBytesMode_SIGN_BYTES_MODE_PROTO, SignBytesMode_SIGN_BYTES_MODE_JCS)),
+		validation.Field(&si.SignatureFormat, validation.In(SignatureFormat_SIGNATURE_FORMAT_RAW, SignatureFormat_SIGNATURE_FORMAT_JWS)),
 	)
 }
 
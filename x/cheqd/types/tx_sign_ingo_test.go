@@ -42,6 +42,65 @@ func TestSignInfoValidation(t *testing.T) {
 			isValid:  false,
 			errorMsg: "signature: must be encoded in Base64.",
 		},
+		{
+			name: "positive: sign doc with expiry height",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method1",
+				Signature:            "aaa=",
+				SignDocVersion:       SignDocVersion1,
+				ExpiryHeight:         100,
+			},
+			isValid: true,
+		},
+		{
+			name: "positive: detached jws",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method1",
+				Signature:            "eyJhbGciOiJFZERTQSIsImtpZCI6ImRpZDpjaGVxZDphYWFhYWFhYWFhYWFhYWFhI21ldGhvZDEifQ..c2ln",
+				SignatureFormat:      SignatureFormat_SIGNATURE_FORMAT_JWS,
+			},
+			isValid: true,
+		},
+		{
+			name: "negative: jws kid doesn't match verification method id",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method2",
+				Signature:            "eyJhbGciOiJFZERTQSIsImtpZCI6ImRpZDpjaGVxZDphYWFhYWFhYWFhYWFhYWFhI21ldGhvZDEifQ..c2ln",
+				SignatureFormat:      SignatureFormat_SIGNATURE_FORMAT_JWS,
+			},
+			isValid:  false,
+			errorMsg: "signature: jws kid must be: did:cheqd:aaaaaaaaaaaaaaaa#method2.",
+		},
+		{
+			name: "negative: unsupported sign doc version",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method1",
+				Signature:            "aaa=",
+				SignDocVersion:       2,
+			},
+			isValid:  false,
+			errorMsg: "sign_doc_version: must be a valid value.",
+		},
+		{
+			name: "negative: legacy sign doc in JCS mode",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method1",
+				Signature:            "aaa=",
+				SignBytesMode:        SignBytesMode_SIGN_BYTES_MODE_JCS,
+			},
+			isValid:  false,
+			errorMsg: "sign_doc_version: must be 1 in JCS mode.",
+		},
+		{
+			name: "negative: expiry height with legacy signature",
+			struct_: SignInfo{
+				VerificationMethodId: "did:cheqd:aaaaaaaaaaaaaaaa#method1",
+				Signature:            "aaa=",
+				ExpiryHeight:         100,
+			},
+			isValid:  false,
+			errorMsg: "expiry_height: must be blank.",
+		},
 	}
 
 	for _, tc := range cases {
@@ -1,11 +1,13 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/cheqd/cheqd-node/x/cheqd/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/multiformats/go-multibase"
 )
 
@@ -149,6 +151,30 @@ func IsJWK() *CustomErrorRule {
 	})
 }
 
+func IsDetachedJWS(kid string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsDetachedJWS must be only applied on string properties")
+		}
+
+		jws, err := utils.ParseDetachedJWS(casted)
+		if err != nil {
+			return err
+		}
+
+		if !utils.Contains(SupportedJWSAlgs, jws.Header.Alg) {
+			return fmt.Errorf("jws alg must be one of: %s", strings.Join(SupportedJWSAlgs, ", "))
+		}
+
+		if jws.Header.Kid != kid {
+			return fmt.Errorf("jws kid must be: %s", kid)
+		}
+
+		return nil
+	})
+}
+
 func HasPrefix(prefix string) *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.(string)
@@ -164,6 +190,39 @@ func HasPrefix(prefix string) *CustomErrorRule {
 	})
 }
 
+// IsUUID checks that the string is a lowercase RFC 4122 UUID
+func IsUUID() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsUUID must be only applied on string properties")
+		}
+
+		if !utils.IsUUID(casted) {
+			return errors.New("must be a UUID")
+		}
+
+		if casted != strings.ToLower(casted) {
+			return fmt.Errorf("UUID must be lowercase: %s", strings.ToLower(casted))
+		}
+
+		return nil
+	})
+}
+
+// IsBech32Address checks that the string is a bech32 encoded account address
+func IsBech32Address() *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsBech32Address must be only applied on string properties")
+		}
+
+		_, err := sdk.AccAddressFromBech32(casted)
+		return err
+	})
+}
+
 func IsUniqueStrList() *CustomErrorRule {
 	return NewCustomErrorRule(func(value interface{}) error {
 		casted, ok := value.([]string)
@@ -178,3 +237,26 @@ func IsUniqueStrList() *CustomErrorRule {
 		return nil
 	})
 }
+
+// IsJSONObject checks that the string is a JSON encoded object containing the required keys
+func IsJSONObject(requiredKeys ...string) *CustomErrorRule {
+	return NewCustomErrorRule(func(value interface{}) error {
+		casted, ok := value.(string)
+		if !ok {
+			panic("IsJSONObject must be only applied on string properties")
+		}
+
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(casted), &keys); err != nil {
+			return errors.New("must be a JSON object")
+		}
+
+		for _, key := range requiredKeys {
+			if _, found := keys[key]; !found {
+				return fmt.Errorf("%s key is required", key)
+			}
+		}
+
+		return nil
+	})
+}
@@ -5,20 +5,67 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 
 	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcec"
 
 	"github.com/lestrrat-go/jwx/jwk"
 )
 
-func ValidateJWK(jwk_string string) error {
+// ParseJWK parses public JWK into a raw key. Secp256k1 EC keys are supported in addition to the keys supported by jwx.
+func ParseJWK(jwkString string) (interface{}, error) {
 	var raw interface{}
-	err := jwk.ParseRawKey([]byte(jwk_string), &raw)
+	err := jwk.ParseRawKey([]byte(jwkString), &raw)
+	if err == nil {
+		return raw, nil
+	}
+
+	// jwx supports secp256k1 only with a build tag, so such keys are parsed here
+	var ecKey struct {
+		Kty string `json:"kty"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	if jsonErr := json.Unmarshal([]byte(jwkString), &ecKey); jsonErr != nil || ecKey.Kty != "EC" || ecKey.Crv != "secp256k1" {
+		return nil, fmt.Errorf("can't parse jwk: %s", err.Error())
+	}
+
+	x, errX := base64.RawURLEncoding.DecodeString(ecKey.X)
+	y, errY := base64.RawURLEncoding.DecodeString(ecKey.Y)
+	if errX != nil || errY != nil {
+		return nil, errors.New("can't parse jwk: invalid secp256k1 coordinates encoding")
+	}
+
+	pubKey := &ecdsa.PublicKey{
+		Curve: btcec.S256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+
+	if !pubKey.Curve.IsOnCurve(pubKey.X, pubKey.Y) {
+		return nil, errors.New("can't parse jwk: secp256k1 point is not on the curve")
+	}
+
+	return pubKey, nil
+}
+
+// IsSecp256k1PubKey returns true if the key is on secp256k1 curve
+func IsSecp256k1PubKey(pubKey ecdsa.PublicKey) bool {
+	return pubKey.Curve == btcec.S256()
+}
+
+func ValidateJWK(jwk_string string) error {
+	raw, err := ParseJWK(jwk_string)
 	if err != nil {
-		return fmt.Errorf("can't parse jwk: %s", err.Error())
+		return err
 	}
 
 	switch key := raw.(type) {
@@ -72,8 +119,13 @@ func VerifyRSASignature(pubKey rsa.PublicKey, message []byte, signature []byte)
 	return nil
 }
 
-// VerifyECDSASignature uses ASN1 to decode r and s, SHA265 to calculate message digest
+// VerifyECDSASignature uses ASN1 to decode r and s, SHA265 to calculate message digest.
+// Secp256k1 keys are supported only for JWS signatures, see VerifyECDSARawSignature.
 func VerifyECDSASignature(pubKey ecdsa.PublicKey, message []byte, signature []byte) error {
+	if IsSecp256k1PubKey(pubKey) {
+		return errors.New("secp256k1 keys can be used only with jws signatures")
+	}
+
 	hasher := crypto.SHA256.New()
 	hasher.Write(message)
 	digest := hasher.Sum(nil)
@@ -84,3 +136,43 @@ func VerifyECDSASignature(pubKey ecdsa.PublicKey, message []byte, signature []by
 	}
 	return nil
 }
+
+// VerifyRSAPKCS1v15Signature uses PKCS #1 v1.5 padding and SHA256 digest (RS256)
+func VerifyRSAPKCS1v15Signature(pubKey rsa.PublicKey, message []byte, signature []byte) error {
+	hasher := crypto.SHA256.New()
+	hasher.Write(message)
+	digest := hasher.Sum(nil)
+
+	return rsa.VerifyPKCS1v15(&pubKey, crypto.SHA256, digest, signature)
+}
+
+// VerifyECDSARawSignature uses r || s signature encoding from RFC 7518 and SHA256 digest (ES256, ES256K)
+func VerifyECDSARawSignature(pubKey ecdsa.PublicKey, message []byte, signature []byte) error {
+	size := (pubKey.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return fmt.Errorf("invalid ecdsa signature length: %d", len(signature))
+	}
+
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+
+	hasher := crypto.SHA256.New()
+	hasher.Write(message)
+	digest := hasher.Sum(nil)
+
+	if IsSecp256k1PubKey(pubKey) {
+		return verifySecp256k1Signature(pubKey, digest, &btcec.Signature{R: r, S: s})
+	}
+
+	if !ecdsa.Verify(&pubKey, digest, r, s) {
+		return errors.New("invalid ecdsa signature")
+	}
+	return nil
+}
+
+func verifySecp256k1Signature(pubKey ecdsa.PublicKey, digest []byte, signature *btcec.Signature) error {
+	if !signature.Verify(digest, (*btcec.PublicKey)(&pubKey)) {
+		return errors.New("invalid secp256k1 signature")
+	}
+	return nil
+}
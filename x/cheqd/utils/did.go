@@ -1,15 +1,20 @@
 package utils
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/multiformats/go-multibase"
 )
 
 var (
-	SplitDIDRegexp, _     = regexp.Compile(`^did:([^:]+?)(:([^:]+?))?:([^:]+)$`)
+	SplitDIDRegexp, _     = regexp.Compile(`^did:([^:]+?)(:([^:]+?))?:([a-zA-Z0-9\.\-_]+)$`)
 	DidNamespaceRegexp, _ = regexp.Compile(`^[a-zA-Z0-9]*$`)
+	UUIDRegexp, _         = regexp.Compile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 )
 
 // TrySplitDID Validates generic format of DID. It doesn't validate method, name and id content.
@@ -20,7 +25,7 @@ func TrySplitDID(did string) (method string, namespace string, id string, err er
 	// match [1] - cheqd                - method
 	// match [2] - :testnet
 	// match [3] - testnet              - namespace
-	// match [4] - base58str1ng1111     - id
+	// match [4] - base58str1ng1111     - id (base58 string or UUID)
 	matches := SplitDIDRegexp.FindAllStringSubmatch(did, -1)
 	if len(matches) != 1 {
 		return "", "", "", errors.New("unable to split did into method, namespace and id")
@@ -79,6 +84,15 @@ func ValidateDID(did string, method string, allowedNamespaces []string) error {
 }
 
 func ValidateUniqueId(uniqueId string) error {
+	// UUIDs are stored in the normalised lowercase form
+	if IsUUID(uniqueId) {
+		if uniqueId != NormalizeId(uniqueId) {
+			return fmt.Errorf("unique id in UUID format must be lowercase: %s", NormalizeId(uniqueId))
+		}
+
+		return nil
+	}
+
 	// Length should be 16 or 32 symbols
 	if len(uniqueId) != 16 && len(uniqueId) != 32 {
 		return fmt.Errorf("unique id length should be 16 or 32 symbols")
@@ -96,3 +110,45 @@ func IsValidDID(did string, method string, allowedNamespaces []string) bool {
 	err := ValidateDID(did, method, allowedNamespaces)
 	return err == nil
 }
+
+// IsUUID checks whether the unique id is an RFC 4122 UUID in the canonical 8-4-4-4-12 form, in any case
+func IsUUID(uniqueId string) bool {
+	return UUIDRegexp.MatchString(uniqueId)
+}
+
+// NormalizeId converts UUID unique ids to lowercase. Base58 ids are case-sensitive and returned as is.
+func NormalizeId(uniqueId string) string {
+	if IsUUID(uniqueId) {
+		return strings.ToLower(uniqueId)
+	}
+
+	return uniqueId
+}
+
+// NormalizeDID normalises the unique id of the DID. Strings that are not DIDs are returned as is.
+func NormalizeDID(did string) string {
+	method, namespace, id, err := TrySplitDID(did)
+	if err != nil {
+		return did
+	}
+
+	return JoinDID(method, namespace, NormalizeId(id))
+}
+
+// DeriveSelfCertifyingId derives the unique id from an Ed25519 public key: the last 32 symbols of base58 of the sha256 hash of the key.
+// The encoding of the hash is 43-44 symbols long. Its last symbols are uniformly distributed, unlike the first ones,
+// so they are used as the 32 symbols unique id.
+func DeriveSelfCertifyingId(pubKey ed25519.PublicKey) (string, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return "", fmt.Errorf("ed25519: bad public key length: %d", len(pubKey))
+	}
+
+	hash := sha256.Sum256(pubKey)
+
+	encoded, err := multibase.Encode(multibase.Base58BTC, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return encoded[len(encoded)-32:], nil
+}
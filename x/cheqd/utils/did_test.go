@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/ed25519"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -24,7 +25,7 @@ func TestIsDid(t *testing.T) {
 		{"Valid: Inputs: Method and Namespaces are not set and passed for NOTcheqd", true, "did:NOTcheqd:123456789abcdefg123456789abcdefg", "", []string{}},
 
 		{"Valid: Inputs: Order of namespaces changed", true, "did:cheqd:testnet:123456789abcdefg", "cheqd", []string{"mainnet", "testnet"}},
-		// Wrong splitting (^did:([^:]+?)(:([^:]+?))?:([^:]+)$)
+		// Wrong splitting (^did:([^:]+?)(:([^:]+?))?:([a-zA-Z0-9\.\-_]+)$)
 		{"Not valid: DID is not started from 'did'", false, "did123:cheqd:::123456789abcdefg", "cheqd", []string{"testnet"}},
 		{"Not valid: empty namespace", false, "did:cheqd::123456789abcdefg", "cheqd", []string{"testnet"}},
 		{"Not valid: a lot of ':'", false, "did:cheqd:::123456789abcdefg", "cheqd", []string{"testnet"}},
@@ -49,6 +50,14 @@ func TestIsDid(t *testing.T) {
 		{"Not valid: UniqueID less then 16 symbols", false, "did:cheqd:testnet:123", "cheqd", []string{}},
 		{"Not valid: UniqueID more then 16 symbols but less then 32", false, "did:cheqd:testnet:123456789abcdefgABCDEF", "cheqd", []string{}},
 		{"Not valid: UniqueID more then 32 symbols", false, "did:cheqd:testnet:123456789abcdefg123456789abcdefgABCDEF", "cheqd", []string{}},
+		// UUID checks
+		{"Valid: UniqueID is UUID", true, "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130", "cheqd", []string{"testnet"}},
+		{"Valid: UniqueID is UUID and namespace is absent", true, "did:cheqd:3b9b8eec-5b5d-4382-86d8-9185126ff130", "cheqd", []string{}},
+		{"Not valid: UniqueID is UUID in uppercase", false, "did:cheqd:testnet:3B9B8EEC-5B5D-4382-86D8-9185126FF130", "cheqd", []string{}},
+		{"Not valid: UniqueID is UUID without hyphens", false, "did:cheqd:testnet:3b9b8eec5b5d438286d89185126ff130", "cheqd", []string{}},
+		{"Not valid: UniqueID is UUID with wrong groups", false, "did:cheqd:testnet:3b9b8eec5-b5d-4382-86d8-9185126ff130", "cheqd", []string{}},
+		{"Not valid: UniqueID is UUID with non-hex symbols", false, "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff13g", "cheqd", []string{}},
+		{"Not valid: UniqueID is UUID in braces", false, "did:cheqd:testnet:{3b9b8eec-5b5d-4382-86d8-9185126ff130}", "cheqd", []string{}},
 	}
 
 	for _, tc := range cases {
@@ -74,6 +83,8 @@ func TestSplitJoin(t *testing.T) {
 		"did:NOTcheqd:123456789abcdefg",
 		"did:NOTcheqd:123456789abcdefg123456789abcdefg",
 		"did:cheqd:testnet:123456789abcdefg",
+		"did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130",
+		"did:cheqd:3b9b8eec-5b5d-4382-86d8-9185126ff130",
 	}
 
 	for _, tc := range cases {
@@ -95,3 +106,48 @@ func TestMustSplitDID(t *testing.T) {
 	require.Equal(t, "mainnet", namespace)
 	require.Equal(t, "qqqqqqqqqqqqqqqq", id)
 }
+
+func TestNormalizeDID(t *testing.T) {
+	cases := []struct {
+		name     string
+		did      string
+		expected string
+	}{
+		{"UUID in uppercase", "did:cheqd:testnet:3B9B8EEC-5B5D-4382-86D8-9185126FF130", "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130"},
+		{"UUID in mixed case", "did:cheqd:testnet:3b9B8eec-5b5D-4382-86d8-9185126Ff130", "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130"},
+		{"UUID in lowercase", "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130", "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130"},
+		{"Base58 is case-sensitive", "did:cheqd:testnet:123456789abcdefG", "did:cheqd:testnet:123456789abcdefG"},
+		{"Not a DID", "not did", "not did"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, NormalizeDID(tc.did))
+		})
+	}
+}
+
+func TestDeriveSelfCertifyingId(t *testing.T) {
+	pubKey := make(ed25519.PublicKey, ed25519.PublicKeySize)
+	for i := range pubKey {
+		pubKey[i] = byte(i + 1)
+	}
+
+	id, err := DeriveSelfCertifyingId(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "zsVt4N7Pmcby3Mzxcvxjw19Dt9TSu4z4", id)
+	require.NoError(t, ValidateUniqueId(id))
+
+	// The id depends on the whole key
+	pubKey[ed25519.PublicKeySize-1]++
+	other, err := DeriveSelfCertifyingId(pubKey)
+	require.NoError(t, err)
+	require.NotEqual(t, id, other)
+
+	id, err = DeriveSelfCertifyingId(make(ed25519.PublicKey, ed25519.PublicKeySize))
+	require.NoError(t, err)
+	require.Equal(t, "w1ncRJZCCZAizgq4rwCftTKYLce8RU8t", id)
+
+	_, err = DeriveSelfCertifyingId(pubKey[:16])
+	require.Error(t, err)
+}
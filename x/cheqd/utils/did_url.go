@@ -59,6 +59,16 @@ func JoinDIDUrl(did string, path string, query string, fragment string) string {
 	return res
 }
 
+// NormalizeDIDUrl normalises the DID part of the DID URL, see NormalizeDID
+func NormalizeDIDUrl(didUrl string) string {
+	did, path, query, fragment, err := TrySplitDIDUrl(didUrl)
+	if err != nil {
+		return didUrl
+	}
+
+	return JoinDIDUrl(NormalizeDID(did), path, query, fragment)
+}
+
 // ValidateDIDUrl checks method and allowed namespaces only when the corresponding parameters are specified.
 func ValidateDIDUrl(didUrl string, method string, allowedNamespaces []string) error {
 	did, path, query, fragment, err := TrySplitDIDUrl(didUrl)
@@ -31,7 +31,7 @@ func TestIsDidURL(t *testing.T) {
 		{"Not valid: wrong HEXDIG for path (pct-encoded phrase)", false, "did:cheqd:testnet:123456789abcdefg/path%20%zz"},
 		{"Not valid: wrong HEXDIG for query (pct-encoded phrase)", false, "did:cheqd:testnet:123456789abcdefg/path?query%20%zz"},
 		{"Not valid: wrong HEXDIG for fragment (pct-encoded phrase)", false, "did:cheqd:testnet:123456789abcdefg/path?query#fragment%20%zz"},
-		// Wrong splitting (^did:([^:]+?)(:([^:]+?))?:([^:]+)$)
+		// Wrong splitting (^did:([^:]+?)(:([^:]+?))?:([a-zA-Z0-9\.\-_]+)$)
 		{"Not valid: starts with not 'did'", false, "did123:cheqd:::123456789abcdefg/path?query#fragment"},
 		{"Not valid: empty namespace", false, "did:cheqd::123456789abcdefg/path?query#fragment"},
 		{"Not valid: a lot of ':'", false, "did:cheqd:::123456789abcdefg/path?query#fragment"},
@@ -53,6 +53,10 @@ func TestIsDidURL(t *testing.T) {
 		{"Not valid: UniqueID more then 16 symbols but less then 32", false, "did:cheqd:testnet:123456789abcdefgABCDEF/path?query#fragment"},
 		{"Not valid: UniqueID more then 32 symbols", false, "did:cheqd:testnet:123456789abcdefg123456789abcdefgABCDEF/path?query#fragment"},
 		{"Not valid: Split should return error", false, "qwerty"},
+		// UUID checks
+		{"Valid: UniqueID is UUID", true, "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130/path?query#fragment"},
+		{"Valid: UniqueID is UUID with fragment only", true, "did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130#key-1"},
+		{"Not valid: UniqueID is UUID in uppercase", false, "did:cheqd:testnet:3B9B8EEC-5B5D-4382-86D8-9185126FF130#key-1"},
 	}
 
 	for _, tc := range cases {
@@ -91,3 +95,10 @@ func TestDidURLJoin(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeDIDUrl(t *testing.T) {
+	require.Equal(t,
+		"did:cheqd:testnet:3b9b8eec-5b5d-4382-86d8-9185126ff130/path?query#Fragment",
+		NormalizeDIDUrl("did:cheqd:testnet:3B9B8EEC-5B5D-4382-86D8-9185126FF130/path?query#Fragment"))
+	require.Equal(t, "did:cheqd:testnet:123456789abcdefG#key-1", NormalizeDIDUrl("did:cheqd:testnet:123456789abcdefG#key-1"))
+}
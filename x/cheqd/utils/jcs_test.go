@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+		isValid  bool
+	}{
+		{
+			// RFC 8785, section 3.2.2
+			name:     "rfc 8785 example",
+			input:    `{"numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001], "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/", "literals": [null, true, false]}`,
+			expected: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+			isValid:  true,
+		},
+		{
+			// RFC 8785, section 3.2.3
+			name:     "rfc 8785 sorting",
+			input:    `{"\u20ac": "Euro Sign", "\r": "Carriage Return", "\ufb33": "Hebrew Letter Dalet With Dagesh", "1": "One", "\ud83d\ude00": "Emoji: Grinning Face", "\u0080": "Control", "\u00f6": "Latin Small Letter O With Diaeresis"}`,
+			expected: "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"ö\":\"Latin Small Letter O With Diaeresis\",\"€\":\"Euro Sign\",\"😀\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+			isValid:  true,
+		},
+		{
+			name:     "nested objects and numbers",
+			input:    `{"b": {"d": -0.0000001, "c": 1e21}, "a": [100, 1e20, -5.5e-7]}`,
+			expected: `{"a":[100,100000000000000000000,-5.5e-7],"b":{"c":1e+21,"d":-1e-7}}`,
+			isValid:  true,
+		},
+		{
+			name:    "invalid json",
+			input:   `{"a": }`,
+			isValid: false,
+		},
+		{
+			name:    "trailing data",
+			input:   `{"a": 1} {"b": 2}`,
+			isValid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := CanonicalizeJSON([]byte(tc.input))
+
+			if tc.isValid {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, string(res))
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
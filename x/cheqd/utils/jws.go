@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	JWSAlgEdDSA  = "EdDSA"
+	JWSAlgRS256  = "RS256"
+	JWSAlgPS256  = "PS256"
+	JWSAlgES256  = "ES256"
+	JWSAlgES256K = "ES256K"
+)
+
+// JWSHeaderB64 is the only critical header parameter supported (RFC 7797)
+const JWSHeaderB64 = "b64"
+
+type JWSHeader struct {
+	Alg  string   `json:"alg"`
+	Kid  string   `json:"kid"`
+	B64  *bool    `json:"b64,omitempty"`
+	Crit []string `json:"crit,omitempty"`
+}
+
+// DetachedJWS is a compact JWS serialization with an empty payload part (RFC 7515, appendix F)
+type DetachedJWS struct {
+	Header          JWSHeader
+	ProtectedHeader string
+	Signature       []byte
+}
+
+// ParseDetachedJWS parses `<protected header>..<signature>` string
+func ParseDetachedJWS(jws string) (DetachedJWS, error) {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return DetachedJWS{}, errors.New("jws must consist of 3 parts")
+	}
+
+	if parts[1] != "" {
+		return DetachedJWS{}, errors.New("jws payload must be detached")
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return DetachedJWS{}, fmt.Errorf("can't decode jws header: %s", err.Error())
+	}
+
+	var header JWSHeader
+	err = json.Unmarshal(headerBytes, &header)
+	if err != nil {
+		return DetachedJWS{}, fmt.Errorf("can't parse jws header: %s", err.Error())
+	}
+
+	if header.Alg == "" {
+		return DetachedJWS{}, errors.New("jws header must contain alg")
+	}
+
+	err = header.validateCrit()
+	if err != nil {
+		return DetachedJWS{}, err
+	}
+
+	if header.B64 != nil && !*header.B64 {
+		return DetachedJWS{}, errors.New("unencoded jws payload is not supported")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return DetachedJWS{}, fmt.Errorf("can't decode jws signature: %s", err.Error())
+	}
+
+	return DetachedJWS{
+		Header:          header,
+		ProtectedHeader: parts[0],
+		Signature:       signature,
+	}, nil
+}
+
+// SigningInput returns bytes covered by the JWS signature for the detached payload
+func (jws DetachedJWS) SigningInput(payload []byte) []byte {
+	return []byte(jws.ProtectedHeader + "." + base64.RawURLEncoding.EncodeToString(payload))
+}
+
+// validateCrit checks critical header parameters according to RFC 7515, section 4.1.11.
+// b64 must be listed in crit when it's used (RFC 7797, section 6).
+func (h JWSHeader) validateCrit() error {
+	if h.Crit != nil && len(h.Crit) == 0 {
+		return errors.New("jws crit must not be empty")
+	}
+
+	for _, param := range h.Crit {
+		if param != JWSHeaderB64 {
+			return fmt.Errorf("unsupported jws critical header parameter: %s", param)
+		}
+	}
+
+	b64Critical := Contains(h.Crit, JWSHeaderB64)
+
+	if b64Critical && h.B64 == nil {
+		return errors.New("jws crit lists b64 but it's missing in the header")
+	}
+
+	if !b64Critical && h.B64 != nil {
+		return errors.New("jws b64 header parameter must be listed in crit")
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDetachedJWS(t *testing.T) {
+	signature := base64.RawURLEncoding.EncodeToString([]byte("signature"))
+
+	cases := []struct {
+		name     string
+		header   string
+		valid    bool
+		errorMsg string
+	}{
+		{"positive", `{"alg":"EdDSA","kid":"did:cheqd:testnet:123456789abcdefg#key1"}`, true, ""},
+		{"positive b64 listed in crit", `{"alg":"EdDSA","kid":"key","b64":true,"crit":["b64"]}`, true, ""},
+		{"negative alg is required", `{"kid":"key"}`, false, "jws header must contain alg"},
+		{"negative b64 not listed in crit", `{"alg":"EdDSA","kid":"key","b64":true}`, false, "jws b64 header parameter must be listed in crit"},
+		{"negative b64 listed in crit but missing", `{"alg":"EdDSA","kid":"key","crit":["b64"]}`, false, "jws crit lists b64 but it's missing in the header"},
+		{"negative unknown crit parameter", `{"alg":"EdDSA","kid":"key","b64":true,"crit":["b64","exp"]}`, false, "unsupported jws critical header parameter: exp"},
+		{"negative empty crit", `{"alg":"EdDSA","kid":"key","crit":[]}`, false, "jws crit must not be empty"},
+		{"negative unencoded payload", `{"alg":"EdDSA","kid":"key","b64":false,"crit":["b64"]}`, false, "unencoded jws payload is not supported"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			jws := base64.RawURLEncoding.EncodeToString([]byte(tc.header)) + ".." + signature
+			_, err := ParseDetachedJWS(jws)
+
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errorMsg)
+			}
+		})
+	}
+}